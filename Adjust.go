@@ -0,0 +1,230 @@
+package date
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Specifies how a date that is not a business day is moved to a business day.
+type BusinessDayConvention uint8
+
+const (
+	// The date is not adjusted.
+	Unadjusted BusinessDayConvention = iota
+	// The date is moved to the first following business day.
+	Following
+	// The date is moved to the first following business day, unless it falls in the next month; then it is moved to the last preceding business day.
+	ModifiedFollowing
+	// The date is moved to the last preceding business day.
+	Preceding
+	// The date is moved to the last preceding business day, unless it falls in the previous month; then it is moved to the first following business day.
+	ModifiedPreceding
+	// The date is moved to the nearest business day; the following one if both are equally far.
+	Nearest
+)
+
+// Modifies a convention to preserve month ends: the last day of a month is moved to the last business day of that month,
+// and other dates are adjusted according to the convention it is combined with, for example Following | EOMRule.
+// EOMRule alone leaves other dates unadjusted.
+const EOMRule BusinessDayConvention = 1 << 7
+
+var businessDayConventionNames = [...]string{
+	Unadjusted:        "unadjusted",
+	Following:         "following",
+	ModifiedFollowing: "modified-following",
+	Preceding:         "preceding",
+	ModifiedPreceding: "modified-preceding",
+	Nearest:           "nearest",
+}
+
+// The accepted names of the conventions, normalized by normalizeConventionName.
+var businessDayConventionAliases = map[string]BusinessDayConvention{
+	"UNADJUSTED":        Unadjusted,
+	"NONE":              Unadjusted,
+	"FOLLOWING":         Following,
+	"F":                 Following,
+	"MODIFIEDFOLLOWING": ModifiedFollowing,
+	"MODFOLLOWING":      ModifiedFollowing,
+	"MF":                ModifiedFollowing,
+	"PRECEDING":         Preceding,
+	"P":                 Preceding,
+	"MODIFIEDPRECEDING": ModifiedPreceding,
+	"MODPRECEDING":      ModifiedPreceding,
+	"MP":                ModifiedPreceding,
+	"NEAREST":           Nearest,
+	"ENDOFMONTH":        EOMRule,
+	"EOM":               EOMRule,
+}
+
+// The accepted suffixes of the names of conventions combined with EOMRule, normalized by normalizeConventionName.
+var endOfMonthSuffixes = []string{"ENDOFMONTH", "EOM"}
+
+// Parses a business-day convention from its name.
+//
+// # Parameters
+//
+//	name string
+//
+// The name of the convention, for example modified-following, Modified Following, MODFOLLOWING, or MF.
+// The name of a convention combined with EOMRule ends with EOM or End of Month, for example modified-following-eom or MF EOM.
+// The name is case-insensitive and spaces, hyphens, and underscores are ignored.
+//
+// # Returns
+//
+//	convention BusinessDayConvention
+//
+// The convention.
+//
+//	err error
+//
+// An error if name is not a known convention.
+func ParseBusinessDayConvention(name string) (convention BusinessDayConvention, err error) {
+	convention, ok := lookupBusinessDayConvention(name)
+	if !ok {
+		return Unadjusted, fmt.Errorf("date.ParseBusinessDayConvention: unknown convention %q", name)
+	}
+	return convention, nil
+}
+
+// Returns the convention with the name, which may end with an EOMRule suffix.
+func lookupBusinessDayConvention(name string) (convention BusinessDayConvention, ok bool) {
+	name = normalizeConventionName(name)
+	if convention, ok = businessDayConventionAliases[name]; ok {
+		return convention, true
+	}
+	for _, suffix := range endOfMonthSuffixes {
+		if base, found := strings.CutSuffix(name, suffix); found {
+			if convention, ok = businessDayConventionAliases[base]; ok && convention&EOMRule == 0 {
+				return convention | EOMRule, true
+			}
+		}
+	}
+	return Unadjusted, false
+}
+
+// Reports whether the convention is a base convention, optionally combined with EOMRule.
+func (convention BusinessDayConvention) valid() bool {
+	return int(convention&^EOMRule) < len(businessDayConventionNames)
+}
+
+// Normalizes a convention name: upper case, without spaces, hyphens, and underscores.
+func normalizeConventionName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' || r == '_' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(name))
+}
+
+// Returns the name of the convention, for example modified-following, end-of-month, or modified-following-eom.
+func (convention BusinessDayConvention) String() string {
+	switch {
+	case !convention.valid():
+		return fmt.Sprintf("BusinessDayConvention(%d)", uint8(convention))
+	case convention == EOMRule:
+		return "end-of-month"
+	case convention&EOMRule != 0:
+		return businessDayConventionNames[convention&^EOMRule] + "-eom"
+	}
+	return businessDayConventionNames[convention]
+}
+
+// Implements the [encoding.TextMarshaler] interface.
+//
+// # Returns
+//
+//	data []byte
+//
+// The name of the convention.
+//
+//	err error
+//
+// An error if the convention is not valid.
+func (convention BusinessDayConvention) MarshalText() (data []byte, err error) {
+	if !convention.valid() {
+		return nil, fmt.Errorf("date.BusinessDayConvention.MarshalText: invalid convention %d", uint8(convention))
+	}
+	return []byte(convention.String()), nil
+}
+
+// Implements the [encoding.TextUnmarshaler] interface.
+//
+// # Parameters
+//
+//	data []byte
+//
+// Text data with any name accepted by ParseBusinessDayConvention.
+//
+// # Returns
+//
+//	err error
+//
+// An error if data is not a known convention.
+func (convention *BusinessDayConvention) UnmarshalText(data []byte) (err error) {
+	value, ok := lookupBusinessDayConvention(string(data))
+	if !ok {
+		return fmt.Errorf("date.BusinessDayConvention.UnmarshalText: unknown convention %q", data)
+	}
+	*convention = value
+	return nil
+}
+
+// Adjusts date to a business day according to convention.
+//
+// # Parameters
+//
+//	date Date
+//
+// The date to adjust.
+//
+//	convention BusinessDayConvention
+//
+// The business-day convention, optionally combined with EOMRule.
+//
+//	calendar Calendar
+//
+// The business-day calendar, for example a BusinessCalendar with a weekend and a holiday predicate.
+//
+// # Returns
+//
+//	result Date
+//
+// The adjusted date.
+//
+// # Remarks
+//
+// A business day is returned unchanged, and so is every date if calendar is nil or convention is not valid.
+// With EOMRule, the last day of a month is moved to the last business day of the month whatever the base convention is.
+// Adjust panics as AddBusinessDays does if calendar has no business days.
+func Adjust(date Date, convention BusinessDayConvention, calendar Calendar) (result Date) {
+	if calendar == nil || !convention.valid() || calendar.IsBusinessDay(date) {
+		return date
+	}
+	if convention&EOMRule != 0 && date.IsLastDayOfMonth() {
+		return date.AddDays(1).PreviousBusinessDay(calendar)
+	}
+	switch convention &^ EOMRule {
+	case Following:
+		return date.NextBusinessDay(calendar)
+	case ModifiedFollowing:
+		if result = date.NextBusinessDay(calendar); result.Month() != date.Month() {
+			result = date.PreviousBusinessDay(calendar)
+		}
+		return result
+	case Preceding:
+		return date.PreviousBusinessDay(calendar)
+	case ModifiedPreceding:
+		if result = date.PreviousBusinessDay(calendar); result.Month() != date.Month() {
+			result = date.NextBusinessDay(calendar)
+		}
+		return result
+	case Nearest:
+		next, previous := date.NextBusinessDay(calendar), date.PreviousBusinessDay(calendar)
+		if date.DaysUntil(next) <= previous.DaysUntil(date) {
+			return next
+		}
+		return previous
+	}
+	return date
+}
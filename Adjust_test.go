@@ -0,0 +1,97 @@
+package date
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAdjust(t *testing.T) {
+	holidays := NewHolidaySet(New(2024, time.April, 1), New(2024, time.December, 25))
+	calendar := NewBusinessCalendar(SaturdaySunday, holidays.Contains)
+	tests := []struct {
+		name       string
+		date       Date
+		convention BusinessDayConvention
+		want       Date
+	}{
+		{name: "Business day", date: New(2024, time.March, 28), convention: Following, want: New(2024, time.March, 28)},
+		{name: "Unadjusted", date: New(2024, time.March, 30), convention: Unadjusted, want: New(2024, time.March, 30)},
+		{name: "Following - over a holiday", date: New(2024, time.March, 30), convention: Following, want: New(2024, time.April, 2)},
+		{name: "Modified following - same month", date: New(2024, time.June, 1), convention: ModifiedFollowing, want: New(2024, time.June, 3)},
+		{name: "Modified following - next month", date: New(2024, time.March, 30), convention: ModifiedFollowing, want: New(2024, time.March, 29)},
+		{name: "Preceding", date: New(2024, time.June, 1), convention: Preceding, want: New(2024, time.May, 31)},
+		{name: "Modified preceding - same month", date: New(2024, time.March, 30), convention: ModifiedPreceding, want: New(2024, time.March, 29)},
+		{name: "Modified preceding - previous month", date: New(2024, time.June, 1), convention: ModifiedPreceding, want: New(2024, time.June, 3)},
+		{name: "Nearest - Saturday", date: New(2024, time.June, 1), convention: Nearest, want: New(2024, time.May, 31)},
+		{name: "Nearest - Sunday", date: New(2024, time.June, 2), convention: Nearest, want: New(2024, time.June, 3)},
+		{name: "Nearest - tie", date: New(2024, time.December, 25), convention: Nearest, want: New(2024, time.December, 26)},
+		{name: "End of month - not a month end", date: New(2024, time.June, 15), convention: EOMRule, want: New(2024, time.June, 15)},
+		{name: "End of month - month end", date: New(2024, time.June, 30), convention: EOMRule, want: New(2024, time.June, 28)},
+		{name: "End of month - business day", date: New(2024, time.July, 31), convention: EOMRule, want: New(2024, time.July, 31)},
+		{name: "Following end of month - month end", date: New(2024, time.March, 31), convention: Following | EOMRule, want: New(2024, time.March, 29)},
+		{name: "Following end of month - not a month end", date: New(2024, time.June, 15), convention: Following | EOMRule, want: New(2024, time.June, 17)},
+		{name: "Following end of month - into the next month", date: New(2024, time.March, 30), convention: Following | EOMRule, want: New(2024, time.April, 2)},
+		{name: "Modified following end of month", date: New(2024, time.August, 31), convention: ModifiedFollowing | EOMRule, want: New(2024, time.August, 30)},
+		{name: "Invalid convention", date: New(2024, time.June, 1), convention: BusinessDayConvention(100), want: New(2024, time.June, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Adjust(tt.date, tt.convention, calendar); got != tt.want {
+				t.Errorf("Adjust() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if got := Adjust(New(2024, time.June, 1), Following, nil); got != New(2024, time.June, 1) {
+		t.Errorf("Adjust() = %v, want 2024-06-01", got)
+	}
+}
+
+func TestParseBusinessDayConvention(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    BusinessDayConvention
+		wantErr bool
+	}{
+		{name: "modified-following", want: ModifiedFollowing},
+		{name: "Modified Following", want: ModifiedFollowing},
+		{name: "MF", want: ModifiedFollowing},
+		{name: "MODPRECEDING", want: ModifiedPreceding},
+		{name: "eom", want: EOMRule},
+		{name: "End of Month", want: EOMRule},
+		{name: "modified-following-eom", want: ModifiedFollowing | EOMRule},
+		{name: "MF EOM", want: ModifiedFollowing | EOMRule},
+		{name: "Following End of Month", want: Following | EOMRule},
+		{name: "eom eom", wantErr: true},
+		{name: "none", want: Unadjusted},
+		{name: "backward", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBusinessDayConvention(tt.name)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseBusinessDayConvention() = %v, %v, want %v, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBusinessDayConvention_Text(t *testing.T) {
+	for base := Unadjusted; base <= Nearest; base++ {
+		for _, convention := range []BusinessDayConvention{base, base | EOMRule} {
+			data, err := convention.MarshalText()
+			if err != nil {
+				t.Fatalf("BusinessDayConvention.MarshalText() error = %v", err)
+			}
+			var got BusinessDayConvention
+			if err := got.UnmarshalText(data); err != nil || got != convention {
+				t.Errorf("BusinessDayConvention.UnmarshalText(%q) = %v, %v, want %v", data, got, err, convention)
+			}
+		}
+	}
+	if got := (ModifiedFollowing | EOMRule).String(); got != "modified-following-eom" {
+		t.Errorf("BusinessDayConvention.String() = %q, want %q", got, "modified-following-eom")
+	}
+	if _, err := BusinessDayConvention(100).MarshalText(); err == nil {
+		t.Errorf("BusinessDayConvention(100).MarshalText() error = nil, want error")
+	}
+}
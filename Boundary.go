@@ -0,0 +1,233 @@
+package date
+
+import (
+	"fmt"
+	"time"
+)
+
+// Specifies a calendar unit to which dates can be truncated.
+type Unit uint8
+
+const (
+	// A day.
+	UnitDay Unit = iota
+	// An ISO 8601 week, starting on Monday.
+	UnitWeek
+	// A calendar month.
+	UnitMonth
+	// A calendar quarter.
+	UnitQuarter
+	// A half of a calendar year.
+	UnitHalf
+	// A calendar year.
+	UnitYear
+	// A week starting on Sunday, as in the United States.
+	UnitSundayWeek
+)
+
+var unitNames = [...]string{
+	UnitDay:        "day",
+	UnitWeek:       "week",
+	UnitMonth:      "month",
+	UnitQuarter:    "quarter",
+	UnitHalf:       "half",
+	UnitYear:       "year",
+	UnitSundayWeek: "sunday-week",
+}
+
+// Returns the name of the unit, for example month.
+func (unit Unit) String() string {
+	if int(unit) < len(unitNames) {
+		return unitNames[unit]
+	}
+	return fmt.Sprintf("Unit(%d)", uint8(unit))
+}
+
+// Returns the length of the unit as a period.
+//
+// # Returns
+//
+//	period Period
+//
+// The period P1D, P1W, P1M, P3M, P6M, or P1Y, or the zero period if the unit is not valid.
+func (unit Unit) Period() (period Period) {
+	switch unit {
+	case UnitDay:
+		return Period{Days: 1}
+	case UnitWeek, UnitSundayWeek:
+		return Period{Weeks: 1}
+	case UnitMonth:
+		return Period{Months: 1}
+	case UnitQuarter:
+		return Period{Months: 3}
+	case UnitHalf:
+		return Period{Months: 6}
+	case UnitYear:
+		return Period{Years: 1}
+	default:
+		return Period{}
+	}
+}
+
+// Returns the first day of the unit containing the date.
+//
+// # Parameters
+//
+//	unit Unit
+//
+// The unit.
+//
+// # Returns
+//
+//	result Date
+//
+// The first day of the day, week, month, quarter, half, or year containing date, or date if the unit is not valid.
+//
+// # Remarks
+//
+// UnitWeek weeks start on Monday and UnitSundayWeek weeks on Sunday; use StartOfWeek for weeks starting on another day.
+func (date Date) Truncate(unit Unit) (result Date) {
+	switch unit {
+	case UnitWeek:
+		return date.StartOfWeek(time.Monday)
+	case UnitSundayWeek:
+		return date.StartOfWeek(time.Sunday)
+	case UnitMonth:
+		return date.StartOfMonth()
+	case UnitQuarter:
+		return date.StartOfQuarter()
+	case UnitHalf:
+		return date.StartOfHalf()
+	case UnitYear:
+		return date.StartOfYear()
+	default:
+		return date
+	}
+}
+
+// Returns the first day of the week containing the date.
+//
+// # Parameters
+//
+//	firstDay time.Weekday
+//
+// The first day of the week, for example time.Monday in Europe or time.Sunday in the United States.
+//
+// # Returns
+//
+//	result Date
+//
+// The last firstDay on or before date.
+func (date Date) StartOfWeek(firstDay time.Weekday) (result Date) {
+	return date.AddDays(-int(date.Weekday()-firstDay+7) % 7)
+}
+
+// Returns the last day of the week containing the date.
+//
+// # Parameters
+//
+//	firstDay time.Weekday
+//
+// The first day of the week, for example time.Monday in Europe or time.Sunday in the United States.
+//
+// # Returns
+//
+//	result Date
+//
+// The sixth day after StartOfWeek(firstDay).
+func (date Date) EndOfWeek(firstDay time.Weekday) (result Date) {
+	return date.StartOfWeek(firstDay).AddDays(6)
+}
+
+// Returns the first day of the month containing the date.
+//
+// # Returns
+//
+//	result Date
+//
+// The first day of the month.
+func (date Date) StartOfMonth() (result Date) {
+	return date.AddDays(1 - date.Day())
+}
+
+// Returns the last day of the month containing the date.
+//
+// # Returns
+//
+//	result Date
+//
+// The last day of the month.
+func (date Date) EndOfMonth() (result Date) {
+	return date.AddDays(date.DaysInMonth() - date.Day())
+}
+
+// Returns the first day of the year containing the date.
+//
+// # Returns
+//
+//	result Date
+//
+// January 1.
+func (date Date) StartOfYear() (result Date) {
+	return date.AddDays(1 - date.YearDay())
+}
+
+// Returns the last day of the year containing the date.
+//
+// # Returns
+//
+//	result Date
+//
+// December 31.
+func (date Date) EndOfYear() (result Date) {
+	return New(date.Year(), time.December, 31)
+}
+
+// Reports whether the date is the last day of its month.
+//
+// # Returns
+//
+//	result bool
+//
+// True if date is the last day of its month, false otherwise.
+func (date Date) IsLastDayOfMonth() (result bool) {
+	return date.Day() == date.DaysInMonth()
+}
+
+// Returns the number of days in the month containing the date.
+//
+// # Returns
+//
+//	days int
+//
+// The number of days, from 28 to 31.
+func (date Date) DaysInMonth() (days int) {
+	year, month, _ := date.Deconstruct()
+	return New(year, month+1, 0).Day()
+}
+
+// Returns the number of days in the year containing the date.
+//
+// # Returns
+//
+//	days int
+//
+// 366 in a leap year, 365 otherwise.
+func (date Date) DaysInYear() (days int) {
+	if date.IsLeapYear() {
+		return 366
+	}
+	return 365
+}
+
+// Reports whether the year containing the date is a leap year of the proleptic Gregorian calendar.
+//
+// # Returns
+//
+//	result bool
+//
+// True if the year is divisible by 4 but not by 100, or divisible by 400; false otherwise.
+func (date Date) IsLeapYear() (result bool) {
+	year := date.Year()
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
@@ -0,0 +1,114 @@
+package date
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDate_StartOfWeek(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     Date
+		firstDay time.Weekday
+		want     Date
+		wantEnd  Date
+	}{
+		{name: "Monday start on Wednesday", date: New(2024, time.May, 15), firstDay: time.Monday, want: New(2024, time.May, 13), wantEnd: New(2024, time.May, 19)},
+		{name: "Sunday start on Wednesday", date: New(2024, time.May, 15), firstDay: time.Sunday, want: New(2024, time.May, 12), wantEnd: New(2024, time.May, 18)},
+		{name: "Monday start on Sunday", date: New(2024, time.May, 19), firstDay: time.Monday, want: New(2024, time.May, 13), wantEnd: New(2024, time.May, 19)},
+		{name: "Sunday start on Sunday", date: New(2024, time.May, 19), firstDay: time.Sunday, want: New(2024, time.May, 19), wantEnd: New(2024, time.May, 25)},
+		{name: "Saturday start across year", date: New(2025, time.January, 2), firstDay: time.Saturday, want: New(2024, time.December, 28), wantEnd: New(2025, time.January, 3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.StartOfWeek(tt.firstDay); got != tt.want {
+				t.Errorf("Date.StartOfWeek() = %v, want %v", got, tt.want)
+			}
+			if got := tt.date.EndOfWeek(tt.firstDay); got != tt.wantEnd {
+				t.Errorf("Date.EndOfWeek() = %v, want %v", got, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestDate_MonthAndYearBoundaries(t *testing.T) {
+	tests := []struct {
+		date         Date
+		startOfMonth Date
+		endOfMonth   Date
+		daysInMonth  int
+		daysInYear   int
+		leap         bool
+	}{
+		{date: New(2024, time.February, 10), startOfMonth: New(2024, time.February, 1), endOfMonth: New(2024, time.February, 29), daysInMonth: 29, daysInYear: 366, leap: true},
+		{date: New(2023, time.February, 28), startOfMonth: New(2023, time.February, 1), endOfMonth: New(2023, time.February, 28), daysInMonth: 28, daysInYear: 365},
+		{date: New(1900, time.February, 1), startOfMonth: New(1900, time.February, 1), endOfMonth: New(1900, time.February, 28), daysInMonth: 28, daysInYear: 365},
+		{date: New(2000, time.December, 31), startOfMonth: New(2000, time.December, 1), endOfMonth: New(2000, time.December, 31), daysInMonth: 31, daysInYear: 366, leap: true},
+		{date: New(2024, time.April, 30), startOfMonth: New(2024, time.April, 1), endOfMonth: New(2024, time.April, 30), daysInMonth: 30, daysInYear: 366, leap: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.date.String(), func(t *testing.T) {
+			if got := tt.date.StartOfMonth(); got != tt.startOfMonth {
+				t.Errorf("Date.StartOfMonth() = %v, want %v", got, tt.startOfMonth)
+			}
+			if got := tt.date.EndOfMonth(); got != tt.endOfMonth {
+				t.Errorf("Date.EndOfMonth() = %v, want %v", got, tt.endOfMonth)
+			}
+			if got := tt.date.IsLastDayOfMonth(); got != (tt.date == tt.endOfMonth) {
+				t.Errorf("Date.IsLastDayOfMonth() = %v, want %v", got, !got)
+			}
+			if got := tt.date.DaysInMonth(); got != tt.daysInMonth {
+				t.Errorf("Date.DaysInMonth() = %d, want %d", got, tt.daysInMonth)
+			}
+			if got := tt.date.DaysInYear(); got != tt.daysInYear {
+				t.Errorf("Date.DaysInYear() = %d, want %d", got, tt.daysInYear)
+			}
+			if got := tt.date.IsLeapYear(); got != tt.leap {
+				t.Errorf("Date.IsLeapYear() = %v, want %v", got, tt.leap)
+			}
+			year := tt.date.Year()
+			if got := tt.date.StartOfYear(); got != New(year, time.January, 1) {
+				t.Errorf("Date.StartOfYear() = %v", got)
+			}
+			if got := tt.date.EndOfYear(); got != New(year, time.December, 31) {
+				t.Errorf("Date.EndOfYear() = %v", got)
+			}
+		})
+	}
+}
+
+func TestDate_Truncate(t *testing.T) {
+	date := New(2024, time.August, 15)
+	tests := []struct {
+		unit Unit
+		want Date
+	}{
+		{unit: UnitDay, want: date},
+		{unit: UnitWeek, want: New(2024, time.August, 12)},
+		{unit: UnitMonth, want: New(2024, time.August, 1)},
+		{unit: UnitQuarter, want: New(2024, time.July, 1)},
+		{unit: UnitHalf, want: New(2024, time.July, 1)},
+		{unit: UnitYear, want: New(2024, time.January, 1)},
+		{unit: UnitSundayWeek, want: New(2024, time.August, 11)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.unit.String(), func(t *testing.T) {
+			got := date.Truncate(tt.unit)
+			if got != tt.want {
+				t.Errorf("Date.Truncate() = %v, want %v", got, tt.want)
+			}
+			if next := got.AddPeriod(tt.unit.Period()); !next.After(date) || got.After(date) {
+				t.Errorf("Date.Truncate() + Unit.Period() = %v does not contain %v", next, date)
+			}
+		})
+	}
+	if got := New(2024, time.August, 18).Truncate(UnitSundayWeek); got != New(2024, time.August, 18) {
+		t.Errorf("Date.Truncate() = %v, want 2024-08-18", got)
+	}
+	if got := date.Truncate(Unit(100)); got != date {
+		t.Errorf("Date.Truncate() = %v, want %v", got, date)
+	}
+	if got := Unit(100).Period(); !got.IsZero() {
+		t.Errorf("Unit.Period() = %v, want the zero period", got)
+	}
+}
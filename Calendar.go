@@ -0,0 +1,290 @@
+package date
+
+import (
+	"fmt"
+	"time"
+)
+
+// Represents a business-day calendar.
+type Calendar interface {
+	// Reports whether date is a business day.
+	IsBusinessDay(date Date) bool
+}
+
+// Represents the set of days of the week that are not business days.
+//
+// # Remarks
+//
+// Weekend is a bit mask in which bit n is set when [time.Weekday](n) is a weekend day.
+type Weekend uint8
+
+const (
+	// Saturday and Sunday, the weekend in most of Europe and the Americas.
+	SaturdaySunday Weekend = 1<<time.Saturday | 1<<time.Sunday
+	// Friday and Saturday, the weekend in most of the Middle East.
+	FridaySaturday Weekend = 1<<time.Friday | 1<<time.Saturday
+	// Sunday only.
+	SundayOnly Weekend = 1 << time.Sunday
+	// No weekend days.
+	NoWeekend Weekend = 0
+)
+
+// Creates a new weekend consisting of the specified days of the week.
+//
+// # Parameters
+//
+//	days ...time.Weekday
+//
+// The days of the week that are not business days.
+//
+// # Returns
+//
+//	weekend Weekend
+//
+// The weekend consisting of days.
+func NewWeekend(days ...time.Weekday) (weekend Weekend) {
+	for _, day := range days {
+		weekend |= 1 << day
+	}
+	return weekend
+}
+
+// Reports whether day is a weekend day.
+//
+// # Parameters
+//
+//	day time.Weekday
+//
+// The day of the week to check.
+//
+// # Returns
+//
+//	result bool
+//
+// True if day is a weekend day, false otherwise.
+func (weekend Weekend) Contains(day time.Weekday) (result bool) {
+	return weekend&(1<<day) != 0
+}
+
+// Represents a set of holidays.
+type HolidaySet map[Date]struct{}
+
+// Creates a new holiday set containing the specified dates.
+//
+// # Parameters
+//
+//	dates ...Date
+//
+// The holidays.
+//
+// # Returns
+//
+//	set HolidaySet
+//
+// The set containing dates.
+func NewHolidaySet(dates ...Date) (set HolidaySet) {
+	set = make(HolidaySet, len(dates))
+	for _, date := range dates {
+		set[date] = struct{}{}
+	}
+	return set
+}
+
+// Reports whether date is in the set.
+//
+// # Parameters
+//
+//	date Date
+//
+// The date to check.
+//
+// # Returns
+//
+//	result bool
+//
+// True if date is a holiday, false otherwise.
+func (set HolidaySet) Contains(date Date) (result bool) {
+	_, result = set[date]
+	return result
+}
+
+// Represents a calendar in which every day that is neither a weekend day nor a holiday is a business day.
+type BusinessCalendar struct {
+	// The days of the week that are not business days.
+	Weekend Weekend
+	// Reports whether a date is a holiday. It can be nil if there are no holidays.
+	Holidays func(Date) bool
+}
+
+// Creates a new instance of the BusinessCalendar structure.
+//
+// # Parameters
+//
+//	weekend Weekend
+//
+// The days of the week that are not business days.
+//
+//	holidays func(Date) bool
+//
+// The predicate reporting whether a date is a holiday, for example [HolidaySet.Contains]. It can be nil.
+//
+// # Returns
+//
+//	calendar BusinessCalendar
+//
+// The business-day calendar.
+func NewBusinessCalendar(weekend Weekend, holidays func(Date) bool) (calendar BusinessCalendar) {
+	return BusinessCalendar{Weekend: weekend, Holidays: holidays}
+}
+
+// Implements the [Calendar] interface.
+//
+// # Parameters
+//
+//	date Date
+//
+// The date to check.
+//
+// # Returns
+//
+//	result bool
+//
+// True if date is neither a weekend day nor a holiday, false otherwise.
+func (calendar BusinessCalendar) IsBusinessDay(date Date) (result bool) {
+	if calendar.Weekend.Contains(date.Weekday()) {
+		return false
+	}
+	return calendar.Holidays == nil || !calendar.Holidays(date)
+}
+
+// Reports whether date is a business day in calendar.
+//
+// # Parameters
+//
+//	calendar Calendar
+//
+// The business-day calendar.
+//
+// # Returns
+//
+//	result bool
+//
+// True if date is a business day, false otherwise.
+func (date Date) IsBusinessDay(calendar Calendar) (result bool) {
+	return calendar.IsBusinessDay(date)
+}
+
+// Returns the date the specified number of business days after date.
+//
+// # Parameters
+//
+//	days int
+//
+// The number of business days to add; negative values move backward.
+//
+//	calendar Calendar
+//
+// The business-day calendar.
+//
+// # Returns
+//
+//	result Date
+//
+// The business day reached after stepping over days business days.
+//
+// # Remarks
+//
+// If days is 0, date is returned unchanged even if it is not a business day.
+// Otherwise, the result is always a business day and date itself is not counted.
+// AddBusinessDays panics if it finds no business day in maxNonBusinessDays consecutive days,
+// as for a calendar whose weekend or holidays cover every day.
+func (date Date) AddBusinessDays(days int, calendar Calendar) (result Date) {
+	step, start := 1, date
+	if days < 0 {
+		step, days = -1, -days
+	}
+	for gap := 0; days > 0; {
+		date = date.AddDays(step)
+		if calendar.IsBusinessDay(date) {
+			days, gap = days-1, 0
+		} else if gap++; gap == maxNonBusinessDays {
+			panic(fmt.Sprintf("date: no business day within %d days from %v in Date.AddBusinessDays; the calendar has no business days", maxNonBusinessDays, start))
+		}
+	}
+	return date
+}
+
+// The largest number of consecutive non-business days that AddBusinessDays steps over.
+const maxNonBusinessDays = 366
+
+// Returns the first business day after date.
+//
+// # Parameters
+//
+//	calendar Calendar
+//
+// The business-day calendar.
+//
+// # Returns
+//
+//	result Date
+//
+// The first business day after date.
+func (date Date) NextBusinessDay(calendar Calendar) (result Date) {
+	return date.AddBusinessDays(1, calendar)
+}
+
+// Returns the last business day before date.
+//
+// # Parameters
+//
+//	calendar Calendar
+//
+// The business-day calendar.
+//
+// # Returns
+//
+//	result Date
+//
+// The last business day before date.
+func (date Date) PreviousBusinessDay(calendar Calendar) (result Date) {
+	return date.AddBusinessDays(-1, calendar)
+}
+
+// Returns the number of business days between start and end.
+//
+// # Parameters
+//
+//	start Date
+//
+// The start date (inclusive).
+//
+//	end Date
+//
+// The end date (exclusive).
+//
+//	calendar Calendar
+//
+// The business-day calendar.
+//
+// # Returns
+//
+//	days int
+//
+// The number of business days in [start, end); if end is before start, minus the number of business days in [end, start).
+//
+// # Remarks
+//
+// For business days start and end, start.AddBusinessDays(BusinessDaysBetween(start, end, calendar), calendar) == end.
+func BusinessDaysBetween(start Date, end Date, calendar Calendar) (days int) {
+	sign := 1
+	if end.Before(start) {
+		start, end, sign = end, start, -1
+	}
+	for date := start; date.Before(end); date = date.AddDays(1) {
+		if calendar.IsBusinessDay(date) {
+			days++
+		}
+	}
+	return sign * days
+}
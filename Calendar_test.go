@@ -0,0 +1,158 @@
+package date
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBusinessCalendar_IsBusinessDay(t *testing.T) {
+	holidays := NewHolidaySet(New(2024, time.December, 25), New(2024, time.December, 26))
+	tests := []struct {
+		name     string
+		calendar BusinessCalendar
+		date     Date
+		want     bool
+	}{
+		{
+			name:     "Weekday",
+			calendar: NewBusinessCalendar(SaturdaySunday, holidays.Contains),
+			date:     New(2024, time.December, 23),
+			want:     true,
+		},
+		{
+			name:     "Saturday",
+			calendar: NewBusinessCalendar(SaturdaySunday, holidays.Contains),
+			date:     New(2024, time.December, 21),
+			want:     false,
+		},
+		{
+			name:     "Holiday",
+			calendar: NewBusinessCalendar(SaturdaySunday, holidays.Contains),
+			date:     New(2024, time.December, 25),
+			want:     false,
+		},
+		{
+			name:     "Sunday without holidays",
+			calendar: NewBusinessCalendar(FridaySaturday, nil),
+			date:     New(2024, time.December, 22),
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.IsBusinessDay(tt.calendar); got != tt.want {
+				t.Errorf("Date.IsBusinessDay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDate_AddBusinessDays(t *testing.T) {
+	calendar := NewBusinessCalendar(SaturdaySunday, NewHolidaySet(New(2024, time.December, 25), New(2024, time.December, 26)).Contains)
+	type args struct {
+		days int
+	}
+	tests := []struct {
+		name string
+		date Date
+		args args
+		want Date
+	}{
+		{
+			name: "Zero",
+			date: New(2024, time.December, 21),
+			args: args{days: 0},
+			want: New(2024, time.December, 21),
+		},
+		{
+			name: "Over holidays",
+			date: New(2024, time.December, 24),
+			args: args{days: 1},
+			want: New(2024, time.December, 27),
+		},
+		{
+			name: "Over weekend",
+			date: New(2024, time.December, 20),
+			args: args{days: 2},
+			want: New(2024, time.December, 24),
+		},
+		{
+			name: "Backward",
+			date: New(2024, time.December, 27),
+			args: args{days: -2},
+			want: New(2024, time.December, 23),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.AddBusinessDays(tt.args.days, calendar); got != tt.want {
+				t.Errorf("Date.AddBusinessDays() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if got, want := New(2024, time.December, 21).NextBusinessDay(calendar), New(2024, time.December, 23); got != want {
+		t.Errorf("Date.NextBusinessDay() = %v, want %v", got, want)
+	}
+	if got, want := New(2024, time.December, 23).PreviousBusinessDay(calendar), New(2024, time.December, 20); got != want {
+		t.Errorf("Date.PreviousBusinessDay() = %v, want %v", got, want)
+	}
+}
+
+func TestDate_AddBusinessDays_NoBusinessDays(t *testing.T) {
+	calendars := []Calendar{
+		NewBusinessCalendar(NewWeekend(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday), nil),
+		NewBusinessCalendar(SaturdaySunday, func(Date) bool { return true }),
+	}
+	for _, calendar := range calendars {
+		for _, days := range []int{1, -1} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("Date.AddBusinessDays(%d) did not panic for a calendar without business days", days)
+					}
+				}()
+				New(2024, time.May, 15).AddBusinessDays(days, calendar)
+			}()
+		}
+	}
+}
+
+func TestBusinessDaysBetween(t *testing.T) {
+	calendar := NewBusinessCalendar(SaturdaySunday, NewHolidaySet(New(2024, time.December, 25), New(2024, time.December, 26)).Contains)
+	tests := []struct {
+		name  string
+		start Date
+		end   Date
+		want  int
+	}{
+		{
+			name:  "Same day",
+			start: New(2024, time.December, 23),
+			end:   New(2024, time.December, 23),
+			want:  0,
+		},
+		{
+			name:  "Christmas week",
+			start: New(2024, time.December, 23),
+			end:   New(2024, time.December, 30),
+			want:  3,
+		},
+		{
+			name:  "Backward",
+			start: New(2024, time.December, 30),
+			end:   New(2024, time.December, 23),
+			want:  -3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BusinessDaysBetween(tt.start, tt.end, calendar)
+			if got != tt.want {
+				t.Errorf("BusinessDaysBetween() = %v, want %v", got, tt.want)
+			}
+			if back := tt.start.AddBusinessDays(got, calendar); back != tt.end {
+				t.Errorf("Date.AddBusinessDays() = %v, want %v", back, tt.end)
+			}
+		})
+	}
+}
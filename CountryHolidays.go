@@ -0,0 +1,286 @@
+package date
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A holiday rule that applies in the listed regions only, or in the whole country if regions is empty.
+type regionalRule struct {
+	rule    HolidayRule
+	regions []string
+}
+
+func nationwide(rules ...HolidayRule) []regionalRule {
+	result := make([]regionalRule, len(rules))
+	for i, rule := range rules {
+		result[i] = regionalRule{rule: rule}
+	}
+	return result
+}
+
+func regional(rule HolidayRule, regions ...string) regionalRule {
+	return regionalRule{rule: rule, regions: regions}
+}
+
+// Splits rule valid from the year from into rules skipping the years in which the holiday was moved.
+func except(rule HolidayRule, from int, years ...int) []HolidayRule {
+	var result []HolidayRule
+	for _, year := range years {
+		result = append(result, rule.Between(from, year-1))
+		from = year + 1
+	}
+	return append(result, rule.Between(from, 0))
+}
+
+var (
+	poland = nationwide(
+		FixedHoliday("Nowy Rok", time.January, 1),
+		FixedHoliday("Święto Trzech Króli", time.January, 6).Between(2011, 0),
+		EasterHoliday("Wielkanoc", 0),
+		EasterHoliday("Poniedziałek Wielkanocny", 1),
+		FixedHoliday("Święto Pracy", time.May, 1),
+		FixedHoliday("Święto Narodowe Trzeciego Maja", time.May, 3),
+		EasterHoliday("Zielone Świątki", 49),
+		EasterHoliday("Boże Ciało", 60),
+		FixedHoliday("Wniebowzięcie Najświętszej Maryi Panny", time.August, 15),
+		FixedHoliday("Wszystkich Świętych", time.November, 1),
+		FixedHoliday("Narodowe Święto Niepodległości", time.November, 11),
+		FixedHoliday("Wigilia Bożego Narodzenia", time.December, 24).Between(2025, 0),
+		FixedHoliday("Boże Narodzenie", time.December, 25),
+		FixedHoliday("Drugi dzień Bożego Narodzenia", time.December, 26),
+	)
+
+	germany = append(nationwide(
+		FixedHoliday("Neujahr", time.January, 1),
+		EasterHoliday("Karfreitag", -2),
+		EasterHoliday("Ostermontag", 1),
+		FixedHoliday("Tag der Arbeit", time.May, 1),
+		EasterHoliday("Christi Himmelfahrt", 39),
+		EasterHoliday("Pfingstmontag", 50),
+		FixedHoliday("Tag der Deutschen Einheit", time.October, 3).Between(1990, 0),
+		FixedHoliday("Reformationstag", time.October, 31).Between(2017, 2017),
+		WeekdayOnOrAfterHoliday("Buß- und Bettag", time.November, 16, time.Wednesday).Between(1990, 1994),
+		FixedHoliday("Erster Weihnachtstag", time.December, 25),
+		FixedHoliday("Zweiter Weihnachtstag", time.December, 26),
+	),
+		regional(FixedHoliday("Heilige Drei Könige", time.January, 6), "BW", "BY", "ST"),
+		regional(FixedHoliday("Internationaler Frauentag", time.March, 8).Between(2019, 0), "BE"),
+		regional(FixedHoliday("Internationaler Frauentag", time.March, 8).Between(2023, 0), "MV"),
+		regional(EasterHoliday("Ostersonntag", 0), "BB"),
+		regional(FixedHoliday("Tag der Befreiung", time.May, 8).Between(2020, 2020), "BE"),
+		regional(FixedHoliday("Tag der Befreiung", time.May, 8).Between(2025, 2025), "BE"),
+		regional(EasterHoliday("Pfingstsonntag", 49), "BB"),
+		regional(EasterHoliday("Fronleichnam", 60), "BW", "BY", "HE", "NW", "RP", "SL"),
+		regional(FixedHoliday("Mariä Himmelfahrt", time.August, 15), "SL"),
+		regional(FixedHoliday("Weltkindertag", time.September, 20).Between(2019, 0), "TH"),
+		regional(FixedHoliday("Reformationstag", time.October, 31).Between(1990, 2016), "BB", "MV", "SN", "ST", "TH"),
+		regional(FixedHoliday("Reformationstag", time.October, 31).Between(2018, 0), "BB", "MV", "SN", "ST", "TH", "HB", "HH", "NI", "SH"),
+		regional(FixedHoliday("Allerheiligen", time.November, 1), "BW", "BY", "NW", "RP", "SL"),
+		regional(WeekdayOnOrAfterHoliday("Buß- und Bettag", time.November, 16, time.Wednesday).Between(1995, 0), "SN"),
+	)
+
+	unitedStates = nationwide(
+		FixedHoliday("New Year's Day", time.January, 1).Observed(NearestWeekday),
+		NthWeekdayHoliday("Birthday of Martin Luther King, Jr.", time.January, time.Monday, 3).Between(1986, 0),
+		NthWeekdayHoliday("Washington's Birthday", time.February, time.Monday, 3),
+		NthWeekdayHoliday("Memorial Day", time.May, time.Monday, -1),
+		FixedHoliday("Juneteenth National Independence Day", time.June, 19).Between(2021, 0).Observed(NearestWeekday),
+		FixedHoliday("Independence Day", time.July, 4).Observed(NearestWeekday),
+		NthWeekdayHoliday("Labor Day", time.September, time.Monday, 1),
+		NthWeekdayHoliday("Columbus Day", time.October, time.Monday, 2),
+		FixedHoliday("Veterans Day", time.November, 11).Observed(NearestWeekday),
+		NthWeekdayHoliday("Thanksgiving Day", time.November, time.Thursday, 4),
+		FixedHoliday("Christmas Day", time.December, 25).Observed(NearestWeekday),
+	)
+
+	unitedKingdom = slices.Concat(
+		nationwide(
+			FixedHoliday("New Year's Day", time.January, 1).Observed(NextFreeWeekday),
+			EasterHoliday("Good Friday", -2),
+			FixedHoliday("Christmas Day", time.December, 25).Observed(NextFreeWeekday),
+			FixedHoliday("Boxing Day", time.December, 26).Observed(NextFreeWeekday),
+			FixedHoliday("Queen's Golden Jubilee", time.June, 3).Between(2002, 2002),
+			FixedHoliday("Royal Wedding", time.April, 29).Between(2011, 2011),
+			FixedHoliday("Queen's Diamond Jubilee", time.June, 5).Between(2012, 2012),
+			FixedHoliday("Queen's Platinum Jubilee", time.June, 3).Between(2022, 2022),
+			FixedHoliday("State Funeral of Queen Elizabeth II", time.September, 19).Between(2022, 2022),
+			FixedHoliday("Coronation of King Charles III", time.May, 8).Between(2023, 2023),
+			FixedHoliday("Early May bank holiday", time.May, 8).Between(1995, 1995),
+			FixedHoliday("Early May bank holiday", time.May, 8).Between(2020, 2020),
+			FixedHoliday("Spring bank holiday", time.June, 4).Between(2002, 2002),
+			FixedHoliday("Spring bank holiday", time.June, 4).Between(2012, 2012),
+			FixedHoliday("Spring bank holiday", time.June, 2).Between(2022, 2022),
+		),
+		nationwide(except(NthWeekdayHoliday("Early May bank holiday", time.May, time.Monday, 1), 1978, 1995, 2020)...),
+		nationwide(except(NthWeekdayHoliday("Spring bank holiday", time.May, time.Monday, -1), 1971, 2002, 2012, 2022)...),
+		[]regionalRule{
+			regional(FixedHoliday("2nd January", time.January, 2).Observed(NextFreeWeekday), "SCT"),
+			regional(FixedHoliday("St Patrick's Day", time.March, 17).Observed(NextFreeWeekday), "NIR"),
+			regional(EasterHoliday("Easter Monday", 1), "ENG", "WLS", "NIR"),
+			regional(FixedHoliday("Battle of the Boyne", time.July, 12).Observed(NextFreeWeekday), "NIR"),
+			regional(NthWeekdayHoliday("Summer bank holiday", time.August, time.Monday, 1), "SCT"),
+			regional(NthWeekdayHoliday("Summer bank holiday", time.August, time.Monday, -1), "ENG", "WLS", "NIR"),
+			regional(FixedHoliday("St Andrew's Day", time.November, 30).Between(2007, 0).Observed(WeekendToMonday), "SCT"),
+		},
+	)
+
+	france = append(nationwide(
+		FixedHoliday("Jour de l'an", time.January, 1),
+		EasterHoliday("Lundi de Pâques", 1),
+		FixedHoliday("Fête du Travail", time.May, 1),
+		FixedHoliday("Victoire 1945", time.May, 8).Between(1982, 0),
+		EasterHoliday("Ascension", 39),
+		EasterHoliday("Lundi de Pentecôte", 50),
+		FixedHoliday("Fête nationale", time.July, 14),
+		FixedHoliday("Assomption", time.August, 15),
+		FixedHoliday("Toussaint", time.November, 1),
+		FixedHoliday("Armistice 1918", time.November, 11),
+		FixedHoliday("Noël", time.December, 25),
+	),
+		regional(EasterHoliday("Vendredi saint", -2), "57", "67", "68", "6AE"),
+		regional(FixedHoliday("Saint-Étienne", time.December, 26), "57", "67", "68", "6AE"),
+	)
+)
+
+var countries = map[string]struct {
+	rules   []regionalRule
+	regions []string
+}{
+	"DE": {germany, []string{"BB", "BE", "BW", "BY", "HB", "HE", "HH", "MV", "NI", "NW", "RP", "SH", "SL", "SN", "ST", "TH"}},
+	"FR": {france, []string{"57", "67", "68", "6AE"}},
+	"GB": {unitedKingdom, []string{"ENG", "NIR", "SCT", "WLS"}},
+	"PL": {poland, nil},
+	"US": {unitedStates, nil},
+}
+
+// Aliases of country codes that have no nationwide calendar of their own.
+var countryAliases = map[string]string{
+	"GB": "GB-ENG",
+	"UK": "GB-ENG",
+}
+
+var countryHolidays sync.Map
+
+// Returns the public holidays of a country or of a region of a country.
+//
+// # Parameters
+//
+//	code string
+//
+// The ISO 3166-1 alpha-2 code of the country, optionally followed by a hyphen and the ISO 3166-2 code of a region, for example PL, DE-BY, or GB-SCT.
+//
+// # Returns
+//
+//	holidays *Holidays
+//
+// The public holidays.
+//
+//	err error
+//
+// An error if there are no holidays for code or code has a hyphen without a region.
+//
+// # Remarks
+//
+// The supported countries are DE (with its 16 states), FR (with the Alsace-Moselle departments 57, 67, 68, and 6AE), GB (with the nations ENG, NIR, SCT, and WLS), PL, and US (federal holidays).
+// A country code alone selects the holidays of the whole country; GB and UK select the holidays of England.
+// The holidays are named in the official language of the country and observed days are included where the law provides for them.
+// The same *Holidays is returned for every call with the same code.
+func CountryHolidays(code string) (holidays *Holidays, err error) {
+	code = strings.ToUpper(code)
+	if alias, ok := countryAliases[code]; ok {
+		code = alias
+	}
+	if cached, ok := countryHolidays.Load(code); ok {
+		return cached.(*Holidays), nil
+	}
+	country, region, hasRegion := strings.Cut(code, "-")
+	definition, ok := countries[country]
+	if !ok {
+		return nil, fmt.Errorf("date.CountryHolidays: unknown country %q", country)
+	}
+	if hasRegion && !slices.Contains(definition.regions, region) {
+		return nil, fmt.Errorf("date.CountryHolidays: unknown region %q of country %q", region, country)
+	}
+	var rules []HolidayRule
+	for _, rule := range definition.rules {
+		if len(rule.regions) == 0 || slices.Contains(rule.regions, region) {
+			rules = append(rules, rule.rule)
+		}
+	}
+	cached, _ := countryHolidays.LoadOrStore(code, NewHolidays(rules...))
+	return cached.(*Holidays), nil
+}
+
+// Returns the business-day calendar of a country or of a region of a country, with a Saturday and Sunday weekend.
+//
+// # Parameters
+//
+//	code string
+//
+// The ISO 3166 code of the country or region, as for [CountryHolidays].
+//
+// # Returns
+//
+//	calendar BusinessCalendar
+//
+// The business-day calendar.
+//
+//	err error
+//
+// An error if there are no holidays for code or code has a hyphen without a region.
+func CountryCalendar(code string) (calendar BusinessCalendar, err error) {
+	holidays, err := CountryHolidays(code)
+	if err != nil {
+		return BusinessCalendar{}, err
+	}
+	return NewBusinessCalendar(SaturdaySunday, holidays.Contains), nil
+}
+
+// Returns the codes accepted by [CountryHolidays].
+//
+// # Returns
+//
+//	codes []string
+//
+// The sorted country and region codes.
+func CountryCodes() (codes []string) {
+	for country, definition := range countries {
+		codes = append(codes, country)
+		for _, region := range definition.regions {
+			codes = append(codes, country+"-"+region)
+		}
+	}
+	for alias := range countryAliases {
+		if _, ok := countries[alias]; !ok {
+			codes = append(codes, alias)
+		}
+	}
+	slices.Sort(codes)
+	return codes
+}
+
+// Returns the name of the holiday falling on date.
+//
+// # Parameters
+//
+//	holidays *Holidays
+//
+// The holidays, for example the result of [CountryHolidays].
+//
+// # Returns
+//
+//	name string
+//
+// The name of the holiday, or an empty string if date is not a holiday.
+//
+//	ok bool
+//
+// True if date is a holiday or an observed holiday, false otherwise.
+func (date Date) IsHoliday(holidays *Holidays) (name string, ok bool) {
+	holiday, ok := holidays.Lookup(date)
+	return holiday.Name, ok
+}
@@ -0,0 +1,76 @@
+package date
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCountryHolidays(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		date     Date
+		wantName string
+		wantOk   bool
+	}{
+		{name: "PL - Epiphany before 2011", code: "PL", date: New(2010, time.January, 6), wantOk: false},
+		{name: "PL - Epiphany", code: "PL", date: New(2011, time.January, 6), wantName: "Święto Trzech Króli", wantOk: true},
+		{name: "PL - Christmas Eve", code: "pl", date: New(2025, time.December, 24), wantName: "Wigilia Bożego Narodzenia", wantOk: true},
+		{name: "DE - Corpus Christi nationwide", code: "DE", date: New(2024, time.May, 30), wantOk: false},
+		{name: "DE-BY - Corpus Christi", code: "DE-BY", date: New(2024, time.May, 30), wantName: "Fronleichnam", wantOk: true},
+		{name: "DE-SN - Day of Repentance", code: "DE-SN", date: New(2024, time.November, 20), wantName: "Buß- und Bettag", wantOk: true},
+		{name: "DE-HH - Reformation Day", code: "DE-HH", date: New(2018, time.October, 31), wantName: "Reformationstag", wantOk: true},
+		{name: "DE-HH - Reformation Day before 2017", code: "DE-HH", date: New(2016, time.October, 31), wantOk: false},
+		{name: "US - New Year's Day observed in previous year", code: "US", date: New(2021, time.December, 31), wantName: "New Year's Day", wantOk: true},
+		{name: "US - Juneteenth observed", code: "US", date: New(2022, time.June, 20), wantName: "Juneteenth National Independence Day", wantOk: true},
+		{name: "US - Thanksgiving", code: "US", date: New(2024, time.November, 28), wantName: "Thanksgiving Day", wantOk: true},
+		{name: "GB - Golden Jubilee", code: "GB-WLS", date: New(2002, time.June, 3), wantName: "Queen's Golden Jubilee", wantOk: true},
+		{name: "GB - moved Spring bank holiday", code: "GB", date: New(2002, time.June, 4), wantName: "Spring bank holiday", wantOk: true},
+		{name: "GB - moved Early May bank holiday", code: "GB", date: New(2020, time.May, 8), wantName: "Early May bank holiday", wantOk: true},
+		{name: "GB - not on first Monday in 2020", code: "UK", date: New(2020, time.May, 4), wantOk: false},
+		{name: "GB-SCT - 2nd January substitute", code: "GB-SCT", date: New(2022, time.January, 4), wantName: "2nd January", wantOk: true},
+		{name: "GB-SCT - no Easter Monday", code: "GB-SCT", date: New(2024, time.April, 1), wantOk: false},
+		{name: "GB-NIR - St Patrick's Day", code: "GB-NIR", date: New(2024, time.March, 18), wantName: "St Patrick's Day", wantOk: true},
+		{name: "FR - Bastille Day", code: "FR", date: New(2024, time.July, 14), wantName: "Fête nationale", wantOk: true},
+		{name: "FR-67 - Good Friday", code: "FR-67", date: New(2024, time.March, 29), wantName: "Vendredi saint", wantOk: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			holidays, err := CountryHolidays(tt.code)
+			if err != nil {
+				t.Fatalf("CountryHolidays() error = %v", err)
+			}
+			name, ok := tt.date.IsHoliday(holidays)
+			if name != tt.wantName || ok != tt.wantOk {
+				t.Errorf("Date.IsHoliday() = %q, %v, want %q, %v", name, ok, tt.wantName, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestCountryHolidays_Errors(t *testing.T) {
+	for _, code := range []string{"XX", "DE-XX", "PL-MZ", "DE-", "-", ""} {
+		if _, err := CountryHolidays(code); err == nil {
+			t.Errorf("CountryHolidays(%q) error = nil, want error", code)
+		}
+	}
+	for _, code := range CountryCodes() {
+		if _, err := CountryHolidays(code); err != nil {
+			t.Errorf("CountryHolidays(%q) error = %v", code, err)
+		}
+	}
+	if !slices.Contains(CountryCodes(), "DE-BY") {
+		t.Errorf("CountryCodes() = %v, want DE-BY included", CountryCodes())
+	}
+}
+
+func TestCountryCalendar(t *testing.T) {
+	calendar, err := CountryCalendar("PL")
+	if err != nil {
+		t.Fatalf("CountryCalendar() error = %v", err)
+	}
+	if got, want := New(2026, time.April, 30).AddBusinessDays(1, calendar), New(2026, time.May, 4); got != want {
+		t.Errorf("Date.AddBusinessDays() = %v, want %v", got, want)
+	}
+}
@@ -1,13 +1,23 @@
 package date
 
 import (
+	"cmp"
 	"database/sql/driver"
 	"errors"
 	"fmt"
 	"time"
 )
 
-type Date time.Time
+// Represents a calendar date without a time of day and without a location.
+//
+// # Remarks
+//
+// Date is stored as the number of days elapsed since January 1, year 1 of the proleptic Gregorian calendar.
+// The zero value is January 1, year 1.
+// Date is comparable, so it can be compared with == and used as a map key.
+type Date struct {
+	days int32
+}
 
 // Returns the time date + value.
 //
@@ -25,23 +35,24 @@ type Date time.Time
 //
 // The time date + value.
 func (date Date) Add(value time.Duration) (resut time.Time) {
-	return time.Time(date).Add(value)
+	return date.toTime().Add(value)
 }
 
 func (date Date) AddDate(years int, months int, days int) Date {
-	return Date(time.Time(date).AddDate(years, months, days))
+	year, month, day := date.Deconstruct()
+	return New(year+years, month+time.Month(months), day+days)
 }
 
 func (date Date) AddDays(days int) Date {
-	return Date(time.Time(date).AddDate(0, 0, days))
+	return Date{days: date.days + int32(days)}
 }
 
 func (date Date) AddMonths(months int) Date {
-	return Date(time.Time(date).AddDate(0, months, 0))
+	return date.AddDate(0, months, 0)
 }
 
 func (date Date) AddYears(years int) Date {
-	return Date(time.Time(date).AddDate(years, 0, 0))
+	return date.AddDate(years, 0, 0)
 }
 
 // Reports whether the date date is after value.
@@ -56,7 +67,7 @@ func (date Date) AddYears(years int) Date {
 //
 // True if date is after value, false otherwise.
 func (date Date) After(value Date) (result bool) {
-	return time.Time(date).After(time.Time(value))
+	return date.days > value.days
 }
 
 // Reports whether the date date is after value.
@@ -71,16 +82,16 @@ func (date Date) After(value Date) (result bool) {
 //
 // True if date is after value, false otherwise.
 func (date Date) AfterTime(value time.Time) (result bool) {
-	return time.Time(date).After(value)
+	return date.toTime().After(value)
 }
 
 func (date Date) AppendBinary(bytes []byte) ([]byte, error) {
-	return time.Time(date).AppendBinary(bytes)
+	return date.toTime().AppendBinary(bytes)
 }
 
 // AppendFormat is like [Time.Format] but appends the textual representation to bytes and returns the extended buffer.
 func (date Date) AppendFormat(bytes []byte, layout string) []byte {
-	return time.Time(date).AppendFormat(bytes, layout)
+	return date.toTime().AppendFormat(bytes, layout)
 }
 
 // AppendText implements the [encoding.TextAppender] interface.
@@ -101,7 +112,7 @@ func (date Date) AppendFormat(bytes []byte, layout string) []byte {
 //
 // An error if the date cannot be represented as valid YYYY-MM-DD (e.g., the year is out of range).
 func (date Date) AppendText(bytes []byte) (result []byte, err error) {
-	return time.Time(date).AppendText(bytes)
+	return date.toTime().AppendFormat(bytes, time.DateOnly), nil
 }
 
 // Reports whether the date date is before value.
@@ -116,7 +127,7 @@ func (date Date) AppendText(bytes []byte) (result []byte, err error) {
 //
 // True if date is before value, false otherwise.
 func (date Date) Before(value Date) (result bool) {
-	return time.Time(date).Before(time.Time(value))
+	return date.days < value.days
 }
 
 // Reports whether the date date is before value.
@@ -131,7 +142,7 @@ func (date Date) Before(value Date) (result bool) {
 //
 // True if date is before value, false otherwise.
 func (date Date) BeforeTime(value time.Time) bool {
-	return time.Time(date).Before(value)
+	return date.toTime().Before(value)
 }
 
 // Reports whether date and other represent the same date.
@@ -148,7 +159,7 @@ func (date Date) BeforeTime(value time.Time) bool {
 //
 // True if date is equal to other, false otherwise.
 func (date Date) Equal(other Date) bool {
-	return time.Time(date).Equal(time.Time(other))
+	return date.days == other.days
 }
 
 // func (date Date) IsDST() bool {
@@ -161,7 +172,7 @@ func (date Date) Equal(other Date) bool {
 //
 // True if date is January 1, year 1; false otherwise.
 func (date Date) IsZero() bool {
-	return time.Time(date).IsZero()
+	return date.days == 0
 }
 
 // Compares the date with value.
@@ -178,7 +189,7 @@ func (date Date) IsZero() bool {
 //
 // If date is before value, it returns -1; if date is after value, it returns +1; if they're the same, it returns 0.
 func (date Date) Compare(value Date) int {
-	return time.Time(date).Compare(time.Time(value))
+	return cmp.Compare(date.days, value.days)
 }
 
 // Returns the year, month, and day in which date occurs.
@@ -197,7 +208,7 @@ func (date Date) Compare(value Date) int {
 //
 // The day of month in which date occurs.
 func (date Date) Deconstruct() (year int, month time.Month, day int) {
-	return time.Time(date).Date()
+	return civil(int64(date.days))
 }
 
 // Returns the day of the month specified by date.
@@ -208,11 +219,33 @@ func (date Date) Deconstruct() (year int, month time.Month, day int) {
 //
 // The day of the month specified by date.
 func (date Date) Day() int {
-	return time.Time(date).Day()
+	_, _, day := date.Deconstruct()
+	return day
+}
+
+// Returns the number of days from date to value.
+//
+// # Parameters
+//
+//	value Date
+//
+// The date to count the days to.
+//
+// # Returns
+//
+//	days int
+//
+// The number of days from date to value; negative if value is before date.
+//
+// # Remarks
+//
+// It satisfies date.AddDays(date.DaysUntil(value)) == value.
+func (date Date) DaysUntil(value Date) (days int) {
+	return int(value.days - date.days)
 }
 
 func (date Date) Format(layout string) string {
-	return time.Time(date).Format(layout)
+	return date.toTime().Format(layout)
 }
 
 // Implements [fmt.GoStringer] and formats date to be printed in Go source code.
@@ -224,12 +257,12 @@ func (date Date) Format(layout string) string {
 // Formatted date to be printed in Go source code.
 func (date Date) GoString() (goString string) {
 	y, _, d := date.Deconstruct()
-	m := date.Format("January")
+	m := date.Month()
 	return fmt.Sprintf("date.New(%d, time.%s, %d)", y, m, d)
 }
 
 func (date Date) GobEncode() ([]byte, error) {
-	return time.Time(date).GobEncode()
+	return date.toTime().GobEncode()
 }
 
 // Returns the ISO 8601 year and week number in which date occurs.
@@ -250,7 +283,11 @@ func (date Date) GobEncode() ([]byte, error) {
 // Jan 01 to Jan 03 of year n might belong to week 52 or 53 of year n-1,
 // and Dec 29 to Dec 31 might belong to week 1 of year n+1.
 func (date Date) ISOWeek() (year int, week int) {
-	return time.Time(date).ISOWeek()
+	// ISO weeks start on Monday, and the week belongs to the year of its Thursday.
+	thursday := int64(date.days) - int64((date.Weekday()+6)%7) + 3
+	year, _, _ = civil(thursday)
+	week = int((thursday-daysFromCivil(year, time.January, 1))/7) + 1
+	return year, week
 }
 
 // func (date Date) In(location *time.Location) Date {
@@ -278,7 +315,7 @@ func (date Date) ISOWeek() (year int, week int) {
 //
 // If the date cannot be represented as a slices of bytes, then an error is reported.
 func (date Date) MarshalBinary(t time.Time) (data []byte, err error) {
-	return time.Time(date).MarshalBinary()
+	return date.toTime().MarshalBinary()
 }
 
 // Implements the [encoding/json.Marshaler] interface.
@@ -293,11 +330,11 @@ func (date Date) MarshalBinary(t time.Time) (data []byte, err error) {
 //
 // If the date cannot be represented as YYYY-MM-DD (e.g., the year is out of range), then an error is reported.
 func (date Date) MarshalJSON() (data []byte, err error) {
-	return []byte(time.Time(date).Format("\"" + time.DateOnly + "\"")), nil
+	return date.toTime().AppendFormat([]byte{'"'}, time.DateOnly+"\""), nil
 }
 
 func (date Date) MarshalText() ([]byte, error) {
-	return []byte(time.Time(date).Format(time.DateOnly)), nil
+	return date.toTime().AppendFormat(nil, time.DateOnly), nil
 }
 
 // Returns the month of the year specified by date.
@@ -308,7 +345,8 @@ func (date Date) MarshalText() ([]byte, error) {
 //
 // The month of the year specified by date.
 func (date Date) Month() time.Month {
-	return time.Time(date).Month()
+	_, month, _ := date.Deconstruct()
+	return month
 }
 
 // func (date Date) Round(value time.Duration) Date {
@@ -318,7 +356,7 @@ func (date Date) Month() time.Month {
 // Returns the date formatted using the format string "2006-01-02".
 // The returned string is meant for debugging; for a stable serialized representation, use date.MarshalText, date.MarshalBinary, or date.Format with an explicit format string.
 func (date Date) String() string {
-	return time.Time(date).Format(time.DateOnly)
+	return date.Format(time.DateOnly)
 }
 
 // Returns the duration date-value.
@@ -338,7 +376,7 @@ func (date Date) String() string {
 // If the result exceeds the maximum (or minimum) value that can be stored in a [time.Duration], the maximum (or minimum) duration will be returned.
 // To compute date-value for a duration duration, use date.Add(-duration).
 func (date Date) Sub(value time.Time) time.Duration {
-	return time.Time(date).Sub(value)
+	return date.toTime().Sub(value)
 }
 
 // func (date Date) Truncate(value time.Duration) Date {
@@ -361,7 +399,7 @@ func (date Date) Sub(value time.Time) time.Duration {
 //
 // Unix-like operating systems often record time as a 32-bit count of seconds, but since the method here returns a 64-bit value it is valid for billions of years into the past or future.
 func (date Date) Unix() int64 {
-	return time.Time(date).Unix()
+	return (int64(date.days) - unixEpochDays) * secondsPerDay
 }
 
 // Returns date as a Unix time, the number of microseconds elapsed since January 1, 1970 UTC.
@@ -376,7 +414,7 @@ func (date Date) Unix() int64 {
 //
 // The result is undefined if the Unix time in microseconds cannot be represented by an int64 (a date before year -290307 or after year 294246).
 func (date Date) UnixMicro() int64 {
-	return time.Time(date).UnixMicro()
+	return date.Unix() * 1e6
 }
 
 // Returns date as a Unix time, the number of milliseconds elapsed since January 1, 1970 UTC.
@@ -391,7 +429,7 @@ func (date Date) UnixMicro() int64 {
 //
 // The result is undefined if the Unix time in milliseconds cannot be represented by an int64 (a date more than 292 million years before or after 1970).
 func (date Date) UnixMilli() int64 {
-	return time.Time(date).UnixMilli()
+	return date.Unix() * 1e3
 }
 
 // Returns date as a Unix time, the number of nanoseconds elapsed since January 1, 1970 UTC.
@@ -406,7 +444,7 @@ func (date Date) UnixMilli() int64 {
 //
 // The result is undefined if the Unix time in nanoseconds cannot be represented by an int64 (a date before the year 1678 or after 2262).
 func (date Date) UnixNano() int64 {
-	return time.Time(date).UnixNano()
+	return date.Unix() * 1e9
 }
 
 // Implements the [encoding.BinaryUnmarshaler] interface.
@@ -428,7 +466,7 @@ func (date *Date) UnmarshalBinary(data []byte) error {
 	if err != nil {
 		return err
 	}
-	*date = Date(*time)
+	*date = DateOf(*time)
 	return nil
 }
 
@@ -462,7 +500,7 @@ func (date *Date) UnmarshalJSON(data []byte) error {
 	if err != nil {
 		return err
 	}
-	*date = Date(time)
+	*date = DateOf(time)
 	return nil
 }
 
@@ -488,7 +526,7 @@ func (date *Date) UnmarshalText(data []byte) error {
 	if err != nil {
 		return err
 	}
-	*date = Date(time)
+	*date = DateOf(time)
 	return nil
 }
 
@@ -500,7 +538,8 @@ func (date *Date) UnmarshalText(data []byte) error {
 //
 // The day of the week specified by date.
 func (date Date) Weekday() time.Weekday {
-	return time.Time(date).Weekday()
+	// January 1, year 1 was a Monday.
+	return time.Weekday((int64(date.days)%7 + 8) % 7)
 }
 
 // Returns the year in which date occurs.
@@ -511,7 +550,8 @@ func (date Date) Weekday() time.Weekday {
 //
 // The year in which date occurs.
 func (date Date) Year() int {
-	return time.Time(date).Year()
+	year, _, _ := date.Deconstruct()
+	return year
 }
 
 // Returns the day of the year specified by date.
@@ -526,7 +566,8 @@ func (date Date) Year() int {
 //
 // Returned value is in the range [1,365] for non-leap years, and [1,366] in leap years.
 func (date Date) YearDay() int {
-	return time.Time(date).YearDay()
+	year, _, _ := date.Deconstruct()
+	return int(int64(date.days)-daysFromCivil(year, time.January, 1)) + 1
 }
 
 // func (date Date) Zone() (name string, offset int) {
@@ -558,8 +599,20 @@ func (date Date) YearDay() int {
 //	date Date
 //
 // A new instance of the Date structure to the specified year, month, and day.
+//
+// # Remarks
+//
+// Like [time.Date], values of month and day outside their usual ranges are normalized, so October 32 converts to November 1.
 func New(year int, month time.Month, day int) Date {
-	return Date(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+	// Normalize month into [1, 12] carrying the overflow into year.
+	m := int(month) - 1
+	year += m / 12
+	m %= 12
+	if m < 0 {
+		m += 12
+		year--
+	}
+	return Date{days: int32(daysFromCivil(year, time.Month(m+1), 1) + int64(day) - 1)}
 }
 
 // func FromTime(time time.Time) Date {
@@ -573,7 +626,10 @@ func New(year int, month time.Month, day int) Date {
 
 func Parse(layout string, value string) (Date, error) {
 	t, err := time.Parse(layout, value)
-	return Date(t.In(time.UTC).Truncate(24 * time.Hour)), err
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t.UTC()), nil
 }
 
 // Returns the current local date.
@@ -630,7 +686,7 @@ func Since(value time.Time) time.Duration {
 //
 // It is shorthand for value.Sub(date.Today()).
 func Until(value time.Time) time.Duration {
-	return value.Sub(time.Time(Today()))
+	return value.Sub(Today().toTime())
 }
 
 // Implements the [database/sql/driver.Valuer] interface.
@@ -645,7 +701,7 @@ func Until(value time.Time) time.Duration {
 //
 // nil value.
 func (date Date) Value() (value driver.Value, err error) {
-	return date.Format(time.DateOnly), nil
+	return date.String(), nil
 }
 
 // Implements the [database/sql.Scanner] interface.
@@ -703,3 +759,181 @@ func DateOf(time time.Time) (date Date) {
 	year, month, day := time.Date()
 	return New(year, month, day)
 }
+
+// Returns the number of days between start and end.
+//
+// # Parameters
+//
+//	start Date
+//
+// The start date.
+//
+//	end Date
+//
+// The end date.
+//
+// # Returns
+//
+//	days int
+//
+// The number of days between start and end; negative if end is before start.
+//
+// # Remarks
+//
+// It is shorthand for start.DaysUntil(end).
+func DaysBetween(start Date, end Date) (days int) {
+	return start.DaysUntil(end)
+}
+
+// Returns the number of whole weeks between start and end.
+//
+// # Parameters
+//
+//	start Date
+//
+// The start date.
+//
+//	end Date
+//
+// The end date.
+//
+// # Returns
+//
+//	weeks int
+//
+// The number of whole weeks between start and end; negative if end is before start.
+//
+// # Remarks
+//
+// Partial weeks are truncated toward zero, so the result is the largest n for which start.AddDays(7*n) does not pass end.
+func WeeksBetween(start Date, end Date) (weeks int) {
+	return DaysBetween(start, end) / 7
+}
+
+// Returns the number of whole calendar months between start and end.
+//
+// # Parameters
+//
+//	start Date
+//
+// The start date.
+//
+//	end Date
+//
+// The end date.
+//
+// # Returns
+//
+//	months int
+//
+// The number of whole months between start and end; negative if end is before start.
+//
+// # Remarks
+//
+// The result is the largest n (by absolute value) for which start.AddMonths(n) does not pass end.
+// AddMonths normalizes day overflow, so a month counted from the 29th, 30th or 31st of a month
+// is complete only when start.AddMonths(n) is reached.
+// For example, there are 0 months between January 31 and February 28, 2023,
+// because January 31 plus one month is March 3, 2023;
+// there is 1 month between January 31 and March 3, 2023.
+func MonthsBetween(start Date, end Date) (months int) {
+	startYear, startMonth, _ := start.Deconstruct()
+	endYear, endMonth, _ := end.Deconstruct()
+	months = 12*(endYear-startYear) + int(endMonth-startMonth)
+	if start.After(end) {
+		for start.AddMonths(months).Before(end) {
+			months++
+		}
+		// A day overflow of the previous month may still land on or after end, as May 31 minus 3 months is March 3.
+		for !start.AddMonths(months - 1).Before(end) {
+			months--
+		}
+		return months
+	}
+	for start.AddMonths(months).After(end) {
+		months--
+	}
+	return months
+}
+
+// Returns the number of whole years between start and end.
+//
+// # Parameters
+//
+//	start Date
+//
+// The start date.
+//
+//	end Date
+//
+// The end date.
+//
+// # Returns
+//
+//	years int
+//
+// The number of whole years between start and end; negative if end is before start.
+//
+// # Remarks
+//
+// The result is the largest n (by absolute value) for which start.AddYears(n) does not pass end.
+// A year counted from February 29 is complete on March 1 of a non-leap year.
+func YearsBetween(start Date, end Date) (years int) {
+	return MonthsBetween(start, end) / 12
+}
+
+const (
+	// Number of days from January 1, year 1 to January 1, 1970.
+	unixEpochDays = 719162
+	secondsPerDay = 24 * 60 * 60
+)
+
+// Returns date as midnight UTC.
+func (date Date) toTime() time.Time {
+	year, month, day := date.Deconstruct()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// Returns the number of days from January 1, year 1 to the specified date of the proleptic Gregorian calendar.
+// Month must be in the range [1, 12]; day is not validated, so it may overflow into the following months.
+func daysFromCivil(year int, month time.Month, day int) int64 {
+	// Algorithm by Howard Hinnant, http://howardhinnant.github.io/date_algorithms.html.
+	y := int64(year)
+	m := int64(month)
+	if m <= 2 {
+		y--
+	}
+	era := floorDiv(y, 400)
+	yoe := y - era*400
+	mp := (m + 9) % 12
+	doy := (153*mp+2)/5 + int64(day) - 1
+	doe := yoe*365 + yoe/4 - yoe/100 + doy
+	return era*146097 + doe - 306
+}
+
+// Returns the year, month, and day for the number of days elapsed since January 1, year 1.
+func civil(days int64) (year int, month time.Month, day int) {
+	// Shift the epoch to March 1, year 0, so that the leap day is the last day of the year.
+	z := days + 306
+	era := floorDiv(z, 146097)
+	doe := z - era*146097
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365
+	doy := doe - (365*yoe + yoe/4 - yoe/100)
+	mp := (5*doy + 2) / 153
+	d := doy - (153*mp+2)/5 + 1
+	m := (mp+2)%12 + 1
+	y := yoe + era*400
+	if m <= 2 {
+		y++
+	}
+	return int(y), time.Month(m), int(d)
+}
+
+// Returns a/b rounded toward negative infinity.
+func floorDiv(a int64, b int64) int64 {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
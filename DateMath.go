@@ -0,0 +1,224 @@
+package date
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Represents a date math expression, as used by Elasticsearch and Grafana, such as now-1M/M or 2024-05-15||+2w.
+//
+// # Remarks
+//
+// An expression is an anchor followed by operations applied from left to right. The anchor is now or today,
+// which are the same since a Date has no time, or a date in the YYYY-MM-DD format, optionally followed by ||.
+// An operation is +<n><unit> or -<n><unit>, moving the date by n units (1 if n is omitted), or /<unit>,
+// rounding the date to the start of its unit, or to its end when the expression is the end of a range.
+// The units are d (day), w (week, starting on Monday), M (month), q or Q (quarter), and y (year).
+// Moving by months, quarters, or years keeps the day of the month, or takes the last day of a shorter month.
+//
+// A DateMath does not read the clock: the current date is passed to Start, End, and Range, so results are deterministic.
+// The zero value is now.
+type DateMath struct {
+	source     string
+	anchor     Date
+	hasAnchor  bool
+	operations []dateMathOperation
+}
+
+type dateMathOperation struct {
+	// '+', '-', or '/'.
+	operator byte
+	n        int
+	unit     Unit
+}
+
+var dateMathUnits = map[byte]Unit{'d': UnitDay, 'w': UnitWeek, 'M': UnitMonth, 'q': UnitQuarter, 'Q': UnitQuarter, 'y': UnitYear}
+
+// Parses a date math expression.
+//
+// # Parameters
+//
+//	expression string
+//
+// The expression, for example now-1M/M, today+2w, or 2024-05-15||/q. Leading and trailing white space is ignored.
+//
+// # Returns
+//
+//	math DateMath
+//
+// The parsed expression.
+//
+//	err error
+//
+// An error if expression is not a valid date math expression or has a time unit, such as h or m.
+func ParseDateMath(expression string) (math DateMath, err error) {
+	s := strings.TrimSpace(expression)
+	math.source = s
+	switch {
+	case strings.HasPrefix(s, "now"):
+		s = s[3:]
+	case strings.HasPrefix(s, "today"):
+		s = s[5:]
+	case len(s) >= 10:
+		if math.anchor, err = Parse(time.DateOnly, s[:10]); err != nil {
+			return DateMath{}, fmt.Errorf("date.ParseDateMath: invalid anchor in %q: %w", expression, err)
+		}
+		math.hasAnchor = true
+		s = strings.TrimPrefix(s[10:], "||")
+	default:
+		return DateMath{}, fmt.Errorf("date.ParseDateMath: missing anchor in %q", expression)
+	}
+	for s != "" {
+		operation := dateMathOperation{operator: s[0], n: 1}
+		if operation.operator != '+' && operation.operator != '-' && operation.operator != '/' {
+			return DateMath{}, fmt.Errorf("date.ParseDateMath: expected +, -, or / at %q in %q", s, expression)
+		}
+		s = s[1:]
+		if operation.operator != '/' {
+			digits := 0
+			for digits < len(s) && '0' <= s[digits] && s[digits] <= '9' {
+				digits++
+			}
+			if digits > 0 {
+				if digits > 9 {
+					return DateMath{}, fmt.Errorf("date.ParseDateMath: number too large at %q in %q", s, expression)
+				}
+				operation.n, _ = parseDigits(s[:digits])
+				s = s[digits:]
+			}
+		}
+		if s == "" {
+			return DateMath{}, fmt.Errorf("date.ParseDateMath: missing unit at the end of %q", expression)
+		}
+		unit, ok := dateMathUnits[s[0]]
+		if !ok {
+			if strings.IndexByte("hHms", s[0]) >= 0 {
+				return DateMath{}, fmt.Errorf("date.ParseDateMath: time unit %q is not supported in %q", s[0], expression)
+			}
+			return DateMath{}, fmt.Errorf("date.ParseDateMath: unknown unit %q in %q", s[0], expression)
+		}
+		operation.unit = unit
+		math.operations = append(math.operations, operation)
+		s = s[1:]
+	}
+	return math, nil
+}
+
+// Returns the date of the expression, rounding down to the start of units.
+//
+// # Parameters
+//
+//	today Date
+//
+// The current date, for the now and today anchors, usually Today().
+//
+// # Returns
+//
+//	date Date
+//
+// The date; for example, now-1M/M is the first day of the previous month.
+func (math DateMath) Start(today Date) (date Date) {
+	return math.evaluate(today, false)
+}
+
+// Returns the date of the expression, rounding up to the end of units, as for the end of a range.
+//
+// # Parameters
+//
+//	today Date
+//
+// The current date, for the now and today anchors, usually Today().
+//
+// # Returns
+//
+//	date Date
+//
+// The date; for example, now-1M/M is the last day of the previous month.
+func (math DateMath) End(today Date) (date Date) {
+	return math.evaluate(today, true)
+}
+
+// Returns the days from Start to End of the expression.
+//
+// # Parameters
+//
+//	today Date
+//
+// The current date, for the now and today anchors, usually Today().
+//
+// # Returns
+//
+//	result Range
+//
+// The range from Start(today) to End(today) inclusive; for example, now-1M/M is the previous month
+// and now-7d is the single day a week ago.
+func (math DateMath) Range(today Date) (result Range) {
+	return NewRange(math.Start(today), math.End(today))
+}
+
+func (math DateMath) evaluate(today Date, roundUp bool) Date {
+	date := today
+	if math.hasAnchor {
+		date = math.anchor
+	}
+	for _, operation := range math.operations {
+		switch operation.operator {
+		case '+':
+			date = addUnits(date, operation.unit, operation.n)
+		case '-':
+			date = addUnits(date, operation.unit, -operation.n)
+		default:
+			date = date.Truncate(operation.unit)
+			if roundUp {
+				date = date.AddPeriod(operation.unit.Period()).AddDays(-1)
+			}
+		}
+	}
+	return date
+}
+
+// Returns the expression, for example now-1M/M.
+func (math DateMath) String() string {
+	if math.source == "" {
+		return "now"
+	}
+	return math.source
+}
+
+// Implements the [encoding.TextMarshaler] interface.
+//
+// # Returns
+//
+//	data []byte
+//
+// The expression.
+//
+//	err error
+//
+// nil value.
+func (math DateMath) MarshalText() (data []byte, err error) {
+	return []byte(math.String()), nil
+}
+
+// Implements the [encoding.TextUnmarshaler] interface.
+//
+// # Parameters
+//
+//	data []byte
+//
+// Text data.
+//
+// # Returns
+//
+//	err error
+//
+// An error if data is not a valid date math expression.
+func (math *DateMath) UnmarshalText(data []byte) error {
+	parsed, err := ParseDateMath(string(data))
+	if err != nil {
+		return err
+	}
+	*math = parsed
+	return nil
+}
@@ -0,0 +1,104 @@
+package date
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseDateMath(t *testing.T) {
+	today := New(2026, time.October, 14)
+	tests := []struct {
+		expression string
+		wantStart  Date
+		wantEnd    Date
+		wantErr    bool
+	}{
+		{expression: "now", wantStart: today, wantEnd: today},
+		{expression: " today ", wantStart: today, wantEnd: today},
+		{expression: "now-7d", wantStart: New(2026, time.October, 7), wantEnd: New(2026, time.October, 7)},
+		{expression: "today+2w", wantStart: New(2026, time.October, 28), wantEnd: New(2026, time.October, 28)},
+		{expression: "now+d", wantStart: New(2026, time.October, 15), wantEnd: New(2026, time.October, 15)},
+		{expression: "now/d", wantStart: today, wantEnd: today},
+		{expression: "now/w", wantStart: New(2026, time.October, 12), wantEnd: New(2026, time.October, 18)},
+		{expression: "now/M", wantStart: New(2026, time.October, 1), wantEnd: New(2026, time.October, 31)},
+		{expression: "now-1M/M", wantStart: New(2026, time.September, 1), wantEnd: New(2026, time.September, 30)},
+		{expression: "now-1q/q", wantStart: New(2026, time.July, 1), wantEnd: New(2026, time.September, 30)},
+		{expression: "now/Q", wantStart: New(2026, time.October, 1), wantEnd: New(2026, time.December, 31)},
+		{expression: "now-1y/y", wantStart: New(2025, time.January, 1), wantEnd: New(2025, time.December, 31)},
+		{expression: "now/M+1M-1d", wantStart: New(2026, time.October, 31), wantEnd: New(2026, time.November, 29)},
+		{expression: "now-1M/M/w", wantStart: New(2026, time.August, 31), wantEnd: New(2026, time.October, 4)},
+		{expression: "2024-01-31||+1M", wantStart: New(2024, time.February, 29), wantEnd: New(2024, time.February, 29)},
+		{expression: "2024-02-29+1y", wantStart: New(2025, time.February, 28), wantEnd: New(2025, time.February, 28)},
+		{expression: "2024-05-15||/q", wantStart: New(2024, time.April, 1), wantEnd: New(2024, time.June, 30)},
+		{expression: "2024-05-15||", wantStart: New(2024, time.May, 15), wantEnd: New(2024, time.May, 15)},
+		{expression: "2024-05-15-10d", wantStart: New(2024, time.May, 5), wantEnd: New(2024, time.May, 5)},
+		{expression: "now-1h", wantErr: true},
+		{expression: "now/m", wantErr: true},
+		{expression: "now-1x", wantErr: true},
+		{expression: "now-1", wantErr: true},
+		{expression: "now/", wantErr: true},
+		{expression: "now*2d", wantErr: true},
+		{expression: "now-1234567890d", wantErr: true},
+		{expression: "2024-02-30||+1d", wantErr: true},
+		{expression: "yesterday", wantErr: true},
+		{expression: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			math, err := ParseDateMath(tt.expression)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDateMath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := math.Start(today); got != tt.wantStart {
+				t.Errorf("DateMath.Start() = %v, want %v", got, tt.wantStart)
+			}
+			if got := math.End(today); got != tt.wantEnd {
+				t.Errorf("DateMath.End() = %v, want %v", got, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestDateMath_Range(t *testing.T) {
+	math, err := ParseDateMath("now-1M/M")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := math.Range(New(2026, time.March, 31))
+	if want := NewRange(New(2026, time.February, 1), New(2026, time.February, 28)); got != want {
+		t.Errorf("DateMath.Range() = %v, want %v", got, want)
+	}
+	var zero DateMath
+	if got, want := zero.Range(New(2026, time.March, 31)), NewRange(New(2026, time.March, 31), New(2026, time.March, 31)); got != want {
+		t.Errorf("DateMath.Range() = %v, want %v", got, want)
+	}
+}
+
+func TestDateMath_MarshalText(t *testing.T) {
+	type filter struct {
+		From DateMath `json:"from"`
+		To   DateMath `json:"to"`
+	}
+	var f filter
+	if err := json.Unmarshal([]byte(`{"from":"now-1M/M","to":"now/d"}`), &f); err != nil {
+		t.Fatal(err)
+	}
+	today := New(2026, time.October, 14)
+	if got := f.From.Start(today); got != New(2026, time.September, 1) {
+		t.Errorf("DateMath.Start() = %v, want 2026-09-01", got)
+	}
+	data, err := json.Marshal(f)
+	if err != nil || string(data) != `{"from":"now-1M/M","to":"now/d"}` {
+		t.Errorf("json.Marshal() = %s, %v", data, err)
+	}
+	if err := json.Unmarshal([]byte(`{"from":"now-1h"}`), &f); err == nil {
+		t.Error("json.Unmarshal() error = nil, want an error")
+	}
+	if got := (DateMath{}).String(); got != "now" {
+		t.Errorf("DateMath.String() = %q, want %q", got, "now")
+	}
+}
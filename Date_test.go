@@ -667,7 +667,7 @@ func TestToday(t *testing.T) {
 	}{
 		{
 			name: "Test",
-			want: Date(time.Now().UTC().Truncate(24 * time.Hour)),
+			want: DateOf(time.Now()),
 		},
 	}
 	for _, tt := range tests {
@@ -705,7 +705,7 @@ func TestDate_Sub(t *testing.T) {
 			args: args{
 				value: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.FixedZone("My Zone", 3600)),
 			},
-			want: 24 * time.Hour,
+			want: 25 * time.Hour,
 		},
 	}
 	for _, tt := range tests {
@@ -716,3 +716,285 @@ func TestDate_Sub(t *testing.T) {
 		})
 	}
 }
+
+func TestNew(t *testing.T) {
+	type args struct {
+		year  int
+		month time.Month
+		day   int
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Regular",
+			args: args{year: 2024, month: time.February, day: 29},
+			want: "2024-02-29",
+		},
+		{
+			name: "Day overflow",
+			args: args{year: 2023, month: time.February, day: 29},
+			want: "2023-03-01",
+		},
+		{
+			name: "Month overflow",
+			args: args{year: 2023, month: 13, day: 1},
+			want: "2024-01-01",
+		},
+		{
+			name: "Month underflow",
+			args: args{year: 2023, month: 0, day: 1},
+			want: "2022-12-01",
+		},
+		{
+			name: "Day underflow",
+			args: args{year: 2024, month: time.March, day: 0},
+			want: "2024-02-29",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := New(tt.args.year, tt.args.month, tt.args.day).String(); got != tt.want {
+				t.Errorf("New() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDate_Comparable(t *testing.T) {
+	parsed, err := Parse("2006-01-02-07:00", "2000-01-01+00:00")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if parsed != New(2000, time.January, 1) {
+		t.Errorf("Parse() = %#v, want %#v", parsed, New(2000, time.January, 1))
+	}
+	dates := map[Date]string{New(2000, time.January, 1): "new year"}
+	if got := dates[parsed]; got != "new year" {
+		t.Errorf("map lookup = %q, want %q", got, "new year")
+	}
+}
+
+func TestDate_Weekday(t *testing.T) {
+	tests := []struct {
+		name string
+		date Date
+		want time.Weekday
+	}{
+		{
+			name: "Zero date",
+			date: Date{},
+			want: time.Monday,
+		},
+		{
+			name: "Saturday",
+			date: New(2000, time.January, 1),
+			want: time.Saturday,
+		},
+		{
+			name: "Sunday",
+			date: New(2024, time.December, 29),
+			want: time.Sunday,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.Weekday(); got != tt.want {
+				t.Errorf("Date.Weekday() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDate_DaysUntil(t *testing.T) {
+	type args struct {
+		value Date
+	}
+	tests := []struct {
+		name string
+		date Date
+		args args
+		want int
+	}{
+		{
+			name: "Forward across leap day",
+			date: New(2024, time.February, 28),
+			args: args{value: New(2024, time.March, 1)},
+			want: 2,
+		},
+		{
+			name: "Backward",
+			date: New(2000, time.January, 1),
+			args: args{value: New(1999, time.December, 1)},
+			want: -31,
+		},
+		{
+			name: "Beyond time.Duration range",
+			date: New(1, time.January, 1),
+			args: args{value: New(9999, time.December, 31)},
+			want: 3652058,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.date.DaysUntil(tt.args.value); got != tt.want {
+				t.Errorf("Date.DaysUntil() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonthsBetween(t *testing.T) {
+	type args struct {
+		start Date
+		end   Date
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantMonths int
+		wantYears  int
+	}{
+		{
+			name:       "Same day of month",
+			args:       args{start: New(2024, time.January, 15), end: New(2025, time.March, 15)},
+			wantMonths: 14,
+			wantYears:  1,
+		},
+		{
+			name:       "One day short",
+			args:       args{start: New(2024, time.January, 15), end: New(2025, time.January, 14)},
+			wantMonths: 11,
+			wantYears:  0,
+		},
+		{
+			name:       "End of month - incomplete",
+			args:       args{start: New(2023, time.January, 31), end: New(2023, time.February, 28)},
+			wantMonths: 0,
+			wantYears:  0,
+		},
+		{
+			name:       "End of month - complete",
+			args:       args{start: New(2023, time.January, 31), end: New(2023, time.March, 3)},
+			wantMonths: 1,
+			wantYears:  0,
+		},
+		{
+			name:       "Leap day",
+			args:       args{start: New(2024, time.February, 29), end: New(2025, time.March, 1)},
+			wantMonths: 12,
+			wantYears:  1,
+		},
+		{
+			name:       "Backward",
+			args:       args{start: New(2025, time.March, 15), end: New(2024, time.January, 16)},
+			wantMonths: -13,
+			wantYears:  -1,
+		},
+		{
+			name:       "Backward end of month - complete through overflow",
+			args:       args{start: New(2023, time.May, 31), end: New(2023, time.March, 2)},
+			wantMonths: -3,
+			wantYears:  0,
+		},
+		{
+			name:       "Backward end of month - overflow reaches end",
+			args:       args{start: New(2023, time.May, 31), end: New(2023, time.March, 3)},
+			wantMonths: -3,
+			wantYears:  0,
+		},
+		{
+			name:       "Backward end of month - incomplete",
+			args:       args{start: New(2023, time.May, 31), end: New(2023, time.March, 4)},
+			wantMonths: -2,
+			wantYears:  0,
+		},
+		{
+			name:       "Backward end of month - same month",
+			args:       args{start: New(2023, time.March, 31), end: New(2023, time.March, 3)},
+			wantMonths: -1,
+			wantYears:  0,
+		},
+		{
+			name:       "Backward end of month - leap year",
+			args:       args{start: New(2024, time.May, 31), end: New(2024, time.March, 1)},
+			wantMonths: -3,
+			wantYears:  0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			months := MonthsBetween(tt.args.start, tt.args.end)
+			if months != tt.wantMonths {
+				t.Errorf("MonthsBetween() = %v, want %v", months, tt.wantMonths)
+			}
+			if got := YearsBetween(tt.args.start, tt.args.end); got != tt.wantYears {
+				t.Errorf("YearsBetween() = %v, want %v", got, tt.wantYears)
+			}
+			reached := tt.args.start.AddMonths(months)
+			next := tt.args.start.AddMonths(months + 1)
+			if months < 0 {
+				next = tt.args.start.AddMonths(months - 1)
+			}
+			if reached.Compare(tt.args.end)*next.Compare(tt.args.end) > 0 {
+				t.Errorf("MonthsBetween() = %v is not the largest whole number of months", months)
+			}
+		})
+	}
+}
+
+func TestMonthsBetween_Exhaustive(t *testing.T) {
+	days := NewRange(New(2023, time.December, 1), New(2024, time.May, 31))
+	for start := range days.Days() {
+		for end := range days.Days() {
+			want := 0
+			for n := -8; n <= 8; n++ {
+				moved := start.AddMonths(n)
+				inside := !start.After(end) && n >= 0 && !moved.After(end) || start.After(end) && n <= 0 && !moved.Before(end)
+				if inside && max(n, -n) > max(want, -want) {
+					want = n
+				}
+			}
+			if got := MonthsBetween(start, end); got != want {
+				t.Fatalf("MonthsBetween(%v, %v) = %v, want %v", start, end, got, want)
+			}
+		}
+	}
+}
+
+func TestWeeksBetween(t *testing.T) {
+	tests := []struct {
+		name  string
+		start Date
+		end   Date
+		want  int
+	}{
+		{
+			name:  "Whole weeks",
+			start: New(2024, time.January, 1),
+			end:   New(2024, time.January, 15),
+			want:  2,
+		},
+		{
+			name:  "Partial week",
+			start: New(2024, time.January, 1),
+			end:   New(2024, time.January, 14),
+			want:  1,
+		},
+		{
+			name:  "Backward",
+			start: New(2024, time.January, 15),
+			end:   New(2024, time.January, 2),
+			want:  -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WeeksBetween(tt.start, tt.end); got != tt.want {
+				t.Errorf("WeeksBetween() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,658 @@
+package date
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Represents a holiday falling on a particular date.
+type Holiday struct {
+	// The name of the holiday.
+	Name string
+	// The date on which the holiday falls.
+	Date Date
+	// True if Date is the day on which a holiday falling on a weekend is observed, false if it is the actual date.
+	Observed bool
+}
+
+// Specifies how a holiday falling on a weekend is shifted to a weekday.
+type Observance uint8
+
+const (
+	// The holiday is not shifted.
+	NotObserved Observance = iota
+	// A holiday falling on Sunday is observed on the following Monday.
+	SundayToMonday
+	// A holiday falling on Saturday or Sunday is observed on the following Monday.
+	WeekendToMonday
+	// A holiday falling on Saturday is observed on the preceding Friday and one falling on Sunday on the following Monday.
+	NearestWeekday
+	// A holiday falling on Saturday or Sunday is observed on the first following weekday that is not already a holiday.
+	NextFreeWeekday
+)
+
+var observanceNames = [...]string{
+	NotObserved:     "not-observed",
+	SundayToMonday:  "sunday-to-monday",
+	WeekendToMonday: "weekend-to-monday",
+	NearestWeekday:  "nearest-weekday",
+	NextFreeWeekday: "next-free-weekday",
+}
+
+// Returns the name of the observance, for example nearest-weekday.
+func (observance Observance) String() string {
+	if int(observance) < len(observanceNames) {
+		return observanceNames[observance]
+	}
+	return fmt.Sprintf("Observance(%d)", uint8(observance))
+}
+
+// Implements the [encoding.TextMarshaler] interface.
+//
+// # Returns
+//
+//	data []byte
+//
+// The name of the observance.
+//
+//	err error
+//
+// An error if the observance is not valid.
+func (observance Observance) MarshalText() (data []byte, err error) {
+	if int(observance) >= len(observanceNames) {
+		return nil, fmt.Errorf("date.Observance.MarshalText: invalid observance %d", uint8(observance))
+	}
+	return []byte(observanceNames[observance]), nil
+}
+
+// Implements the [encoding.TextUnmarshaler] interface.
+//
+// # Parameters
+//
+//	data []byte
+//
+// Text data.
+//
+// # Returns
+//
+//	err error
+//
+// Error when unmarshal problems, nil otherwise.
+//
+// # Remarks
+//
+// The text must be one of not-observed, sunday-to-monday, weekend-to-monday, nearest-weekday, and next-free-weekday.
+func (observance *Observance) UnmarshalText(data []byte) error {
+	i := slices.Index(observanceNames[:], string(data))
+	if i < 0 {
+		return fmt.Errorf("date.Observance.UnmarshalText: unknown observance %q", data)
+	}
+	*observance = Observance(i)
+	return nil
+}
+
+// Represents a rule computing the date of a holiday in any year.
+//
+// # Remarks
+//
+// Rules are created with FixedHoliday, NthWeekdayHoliday, WeekdayOnOrAfterHoliday, EasterHoliday, OrthodoxEasterHoliday, and DateHoliday.
+// The validity and observance fields can be set on the returned value.
+type HolidayRule struct {
+	// The name of the holiday.
+	Name string
+	// The first year in which the holiday is celebrated; 0 if there is no such year.
+	ValidFrom int
+	// The last year in which the holiday is celebrated; 0 if there is no such year.
+	ValidTo int
+	// The way in which the holiday is shifted when it falls on a weekend.
+	Observance Observance
+	date       func(year int) (Date, bool)
+}
+
+// Creates a rule for a holiday falling on the same month and day every year.
+//
+// # Parameters
+//
+//	name string
+//
+// The name of the holiday.
+//
+//	month time.Month
+//
+// The month of the holiday.
+//
+//	day int
+//
+// The day of month of the holiday.
+//
+// # Returns
+//
+//	rule HolidayRule
+//
+// The holiday rule.
+//
+// # Remarks
+//
+// In years in which month has fewer than day days, for example February 29 in a non-leap year, there is no holiday.
+func FixedHoliday(name string, month time.Month, day int) (rule HolidayRule) {
+	return HolidayRule{Name: name, date: func(year int) (Date, bool) {
+		date := New(year, month, day)
+		return date, date.Month() == month
+	}}
+}
+
+// Creates a rule for a holiday falling on the n-th weekday of a month, for example the fourth Thursday of November.
+//
+// # Parameters
+//
+//	name string
+//
+// The name of the holiday.
+//
+//	month time.Month
+//
+// The month of the holiday.
+//
+//	weekday time.Weekday
+//
+// The day of the week of the holiday.
+//
+//	n int
+//
+// The occurrence of weekday in month: 1 for the first, 2 for the second, and so on; -1 for the last, -2 for the second to last, and so on.
+//
+// # Returns
+//
+//	rule HolidayRule
+//
+// The holiday rule.
+func NthWeekdayHoliday(name string, month time.Month, weekday time.Weekday, n int) (rule HolidayRule) {
+	return HolidayRule{Name: name, date: func(year int) (Date, bool) {
+		return NthWeekdayOfMonth(year, month, weekday, n)
+	}}
+}
+
+// Creates a rule for a holiday falling on the first occurrence of weekday on or after the month and day, for example the Wednesday on or after November 16.
+//
+// # Parameters
+//
+//	name string
+//
+// The name of the holiday.
+//
+//	month time.Month
+//
+// The month of the earliest possible date of the holiday.
+//
+//	day int
+//
+// The day of month of the earliest possible date of the holiday.
+//
+//	weekday time.Weekday
+//
+// The day of the week of the holiday.
+//
+// # Returns
+//
+//	rule HolidayRule
+//
+// The holiday rule.
+func WeekdayOnOrAfterHoliday(name string, month time.Month, day int, weekday time.Weekday) (rule HolidayRule) {
+	return HolidayRule{Name: name, date: func(year int) (Date, bool) {
+		earliest := New(year, month, day)
+		return earliest.AddDays((int(weekday-earliest.Weekday()) + 7) % 7), true
+	}}
+}
+
+// Creates a rule for a holiday falling a fixed number of days from Western Easter Sunday.
+//
+// # Parameters
+//
+//	name string
+//
+// The name of the holiday.
+//
+//	offset int
+//
+// The number of days from Easter Sunday, for example -2 for Good Friday and 60 for Corpus Christi.
+//
+// # Returns
+//
+//	rule HolidayRule
+//
+// The holiday rule.
+func EasterHoliday(name string, offset int) (rule HolidayRule) {
+	return HolidayRule{Name: name, date: func(year int) (Date, bool) {
+		return Easter(year).AddDays(offset), true
+	}}
+}
+
+// Creates a rule for a holiday falling a fixed number of days from Orthodox Easter Sunday.
+//
+// # Parameters
+//
+//	name string
+//
+// The name of the holiday.
+//
+//	offset int
+//
+// The number of days from Orthodox Easter Sunday.
+//
+// # Returns
+//
+//	rule HolidayRule
+//
+// The holiday rule.
+func OrthodoxEasterHoliday(name string, offset int) (rule HolidayRule) {
+	return HolidayRule{Name: name, date: func(year int) (Date, bool) {
+		return OrthodoxEaster(year).AddDays(offset), true
+	}}
+}
+
+// Creates a rule for a one-off holiday falling on date.
+//
+// # Parameters
+//
+//	name string
+//
+// The name of the holiday.
+//
+//	date Date
+//
+// The date of the holiday.
+//
+// # Returns
+//
+//	rule HolidayRule
+//
+// The holiday rule, valid in the year of date only.
+func DateHoliday(name string, date Date) (rule HolidayRule) {
+	year := date.Year()
+	return HolidayRule{Name: name, ValidFrom: year, ValidTo: year, date: func(y int) (Date, bool) {
+		return date, y == year
+	}}
+}
+
+// Returns the rule restricted to the years from through to, both inclusive.
+//
+// # Parameters
+//
+//	from int
+//
+// The first year in which the holiday is celebrated; 0 if there is no such year.
+//
+//	to int
+//
+// The last year in which the holiday is celebrated; 0 if there is no such year.
+//
+// # Returns
+//
+//	result HolidayRule
+//
+// The restricted rule.
+func (rule HolidayRule) Between(from int, to int) (result HolidayRule) {
+	rule.ValidFrom, rule.ValidTo = from, to
+	return rule
+}
+
+// Returns the rule with the specified observance.
+//
+// # Parameters
+//
+//	observance Observance
+//
+// The way in which the holiday is shifted when it falls on a weekend.
+//
+// # Returns
+//
+//	result HolidayRule
+//
+// The rule with observance.
+func (rule HolidayRule) Observed(observance Observance) (result HolidayRule) {
+	rule.Observance = observance
+	return rule
+}
+
+// Returns the actual date of the holiday in year.
+//
+// # Parameters
+//
+//	year int
+//
+// The year.
+//
+// # Returns
+//
+//	date Date
+//
+// The actual date of the holiday, before any observance shift.
+//
+//	ok bool
+//
+// True if the holiday is celebrated in year, false otherwise.
+func (rule HolidayRule) Date(year int) (date Date, ok bool) {
+	if rule.date == nil || (rule.ValidFrom != 0 && year < rule.ValidFrom) || (rule.ValidTo != 0 && year > rule.ValidTo) {
+		return Date{}, false
+	}
+	return rule.date(year)
+}
+
+// Represents a set of holidays computed from rules.
+//
+// # Remarks
+//
+// The holidays of each year are computed on first use and cached.
+// Holidays is safe for concurrent use by multiple goroutines.
+type Holidays struct {
+	rules []HolidayRule
+	mutex sync.RWMutex
+	years map[int]*holidayYear
+}
+
+type holidayYear struct {
+	holidays []Holiday
+	byDate   map[Date]int
+}
+
+// Creates a new set of holidays computed from rules.
+//
+// # Parameters
+//
+//	rules ...HolidayRule
+//
+// The holiday rules.
+//
+// # Returns
+//
+//	holidays *Holidays
+//
+// The set of holidays.
+func NewHolidays(rules ...HolidayRule) (holidays *Holidays) {
+	return &Holidays{rules: slices.Clone(rules), years: map[int]*holidayYear{}}
+}
+
+// Returns the rules of the holidays.
+//
+// # Returns
+//
+//	rules []HolidayRule
+//
+// A copy of the rules.
+func (holidays *Holidays) Rules() (rules []HolidayRule) {
+	return slices.Clone(holidays.rules)
+}
+
+// Returns the holidays falling in year, including observed days, in chronological order.
+//
+// # Parameters
+//
+//	year int
+//
+// The year.
+//
+// # Returns
+//
+//	result []Holiday
+//
+// The holidays falling in year.
+//
+// # Remarks
+//
+// An observed day can fall in a different year than the holiday itself;
+// for example New Year's Day 2022 falling on Saturday is observed on Friday, December 31, 2021 with NearestWeekday.
+func (holidays *Holidays) InYear(year int) (result []Holiday) {
+	return slices.Clone(holidays.year(year).holidays)
+}
+
+// Returns the holiday falling on date.
+//
+// # Parameters
+//
+//	date Date
+//
+// The date to check.
+//
+// # Returns
+//
+//	holiday Holiday
+//
+// The holiday falling on date. If there are several, the one whose rule comes first is returned.
+//
+//	ok bool
+//
+// True if date is a holiday or an observed holiday, false otherwise.
+func (holidays *Holidays) Lookup(date Date) (holiday Holiday, ok bool) {
+	year := holidays.year(date.Year())
+	i, ok := year.byDate[date]
+	if !ok {
+		return Holiday{}, false
+	}
+	return year.holidays[i], true
+}
+
+// Reports whether date is a holiday or an observed holiday.
+//
+// # Parameters
+//
+//	date Date
+//
+// The date to check.
+//
+// # Returns
+//
+//	result bool
+//
+// True if date is a holiday, false otherwise.
+//
+// # Remarks
+//
+// The method value holidays.Contains can be used as the holiday predicate of a [BusinessCalendar].
+func (holidays *Holidays) Contains(date Date) (result bool) {
+	_, result = holidays.Lookup(date)
+	return result
+}
+
+func (holidays *Holidays) year(year int) *holidayYear {
+	holidays.mutex.RLock()
+	cached, ok := holidays.years[year]
+	holidays.mutex.RUnlock()
+	if ok {
+		return cached
+	}
+	// Observed days can move across the year boundary, so the neighboring years are computed as well.
+	var all []Holiday
+	for y := year - 1; y <= year+1; y++ {
+		for _, holiday := range holidays.compute(y) {
+			if holiday.Date.Year() == year {
+				all = append(all, holiday)
+			}
+		}
+	}
+	slices.SortStableFunc(all, func(a Holiday, b Holiday) int {
+		return a.Date.Compare(b.Date)
+	})
+	cached = &holidayYear{holidays: all, byDate: make(map[Date]int, len(all))}
+	for i, holiday := range all {
+		if _, ok := cached.byDate[holiday.Date]; !ok {
+			cached.byDate[holiday.Date] = i
+		}
+	}
+	holidays.mutex.Lock()
+	defer holidays.mutex.Unlock()
+	if existing, ok := holidays.years[year]; ok {
+		return existing
+	}
+	if holidays.years == nil {
+		holidays.years = map[int]*holidayYear{}
+	}
+	holidays.years[year] = cached
+	return cached
+}
+
+// Computes the holidays of the rules for year, followed by the observed days.
+func (holidays *Holidays) compute(year int) []Holiday {
+	var actual []Holiday
+	var observances []Observance
+	taken := map[Date]bool{}
+	for _, rule := range holidays.rules {
+		if date, ok := rule.Date(year); ok {
+			actual = append(actual, Holiday{Name: rule.Name, Date: date})
+			observances = append(observances, rule.Observance)
+			taken[date] = true
+		}
+	}
+	// Shift the holidays in chronological order, so that substitute days are assigned first come, first served.
+	order := make([]int, len(actual))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a int, b int) int {
+		return actual[a].Date.Compare(actual[b].Date)
+	})
+	result := actual
+	for _, i := range order {
+		observed := observances[i].shift(actual[i].Date, taken)
+		if observed != actual[i].Date {
+			taken[observed] = true
+			result = append(result, Holiday{Name: actual[i].Name, Date: observed, Observed: true})
+		}
+	}
+	return result
+}
+
+// Returns the day on which a holiday falling on date is observed.
+func (observance Observance) shift(date Date, taken map[Date]bool) Date {
+	weekday := date.Weekday()
+	switch observance {
+	case SundayToMonday:
+		if weekday == time.Sunday {
+			return date.AddDays(1)
+		}
+	case WeekendToMonday:
+		switch weekday {
+		case time.Saturday:
+			return date.AddDays(2)
+		case time.Sunday:
+			return date.AddDays(1)
+		}
+	case NearestWeekday:
+		switch weekday {
+		case time.Saturday:
+			return date.AddDays(-1)
+		case time.Sunday:
+			return date.AddDays(1)
+		}
+	case NextFreeWeekday:
+		if weekday != time.Saturday && weekday != time.Sunday {
+			return date
+		}
+		for {
+			date = date.AddDays(1)
+			if weekday := date.Weekday(); weekday != time.Saturday && weekday != time.Sunday && !taken[date] {
+				return date
+			}
+		}
+	}
+	return date
+}
+
+// Returns the date of Western (Gregorian) Easter Sunday in year.
+//
+// # Parameters
+//
+//	year int
+//
+// The year.
+//
+// # Returns
+//
+//	date Date
+//
+// The date of Easter Sunday.
+func Easter(year int) (date Date) {
+	// Anonymous Gregorian algorithm (Meeus/Jones/Butcher).
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	n := h + l - 7*m + 114
+	return New(year, time.Month(n/31), n%31+1)
+}
+
+// Returns the date of Orthodox (Julian) Easter Sunday in year, expressed in the Gregorian calendar.
+//
+// # Parameters
+//
+//	year int
+//
+// The year.
+//
+// # Returns
+//
+//	date Date
+//
+// The date of Orthodox Easter Sunday.
+func OrthodoxEaster(year int) (date Date) {
+	// Meeus Julian algorithm.
+	a, b, c := year%4, year%7, year%19
+	d := (19*c + 15) % 30
+	e := (2*a + 4*b - d + 34) % 7
+	n := d + e + 114
+	// The difference between the Julian and Gregorian calendars in March and later months.
+	difference := year/100 - year/400 - 2
+	return New(year, time.Month(n/31), n%31+1+difference)
+}
+
+// Returns the n-th occurrence of weekday in the month.
+//
+// # Parameters
+//
+//	year int
+//
+// The year.
+//
+//	month time.Month
+//
+// The month.
+//
+//	weekday time.Weekday
+//
+// The day of the week.
+//
+//	n int
+//
+// The occurrence of weekday: 1 for the first, 2 for the second, and so on; -1 for the last, -2 for the second to last, and so on.
+//
+// # Returns
+//
+//	date Date
+//
+// The n-th weekday of the month.
+//
+//	ok bool
+//
+// False if the month has fewer than |n| occurrences of weekday or n is 0, true otherwise.
+func NthWeekdayOfMonth(year int, month time.Month, weekday time.Weekday, n int) (date Date, ok bool) {
+	switch {
+	case n > 0:
+		first := New(year, month, 1)
+		date = first.AddDays((int(weekday-first.Weekday())+7)%7 + 7*(n-1))
+	case n < 0:
+		last := New(year, month+1, 0)
+		date = last.AddDays(-(int(last.Weekday()-weekday)+7)%7 + 7*(n+1))
+	default:
+		return Date{}, false
+	}
+	if date.Month() != month || date.Year() != year {
+		return Date{}, false
+	}
+	return date, true
+}
@@ -0,0 +1,473 @@
+package date
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Loads holidays from a file.
+//
+// # Parameters
+//
+//	name string
+//
+// The name of the file. Files with the .ics extension are read with [LoadICalendar]; other files are read with [LoadHolidayDefinitions].
+//
+// # Returns
+//
+//	holidays *Holidays
+//
+// The holidays defined in the file.
+//
+//	err error
+//
+// An error if the file cannot be read or parsed. Parse errors include the file name and line.
+func LoadHolidaysFile(name string) (holidays *Holidays, err error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	if strings.EqualFold(filepath.Ext(name), ".ics") {
+		holidays, err = LoadICalendar(file)
+	} else {
+		holidays, err = LoadHolidayDefinitions(file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return holidays, nil
+}
+
+// Loads holidays from the all-day events of an RFC 5545 iCalendar stream.
+//
+// # Parameters
+//
+//	reader io.Reader
+//
+// The iCalendar data.
+//
+// # Returns
+//
+//	holidays *Holidays
+//
+// The holidays named after the SUMMARY of each event.
+//
+//	err error
+//
+// An error if the data cannot be parsed. The error reports the line at which parsing failed.
+//
+// # Remarks
+//
+// Every VEVENT whose DTSTART has the VALUE=DATE parameter (or a date-only value) is a holiday.
+// An event lasts until DTEND (exclusive) or for its DURATION, so it can cover several days; by default it lasts one day.
+// An event that ends on or before its start is reported as an error.
+// Events with a date-time DTSTART, such as early closes, and cancelled events are skipped.
+// Components nested in an event, such as VALARM, are ignored.
+// Recurring events (RRULE, RDATE) are reported as errors.
+func LoadICalendar(reader io.Reader) (holidays *Holidays, err error) {
+	type event struct {
+		line     int
+		summary  string
+		start    Date
+		end      Date
+		duration Period
+		// The line of DTEND or DURATION.
+		endLine int
+		allDay  bool
+		skip    bool
+		// The number of open components nested in the event, such as VALARM.
+		depth int
+	}
+	var rules []HolidayRule
+	var current *event
+	lines := newContentLineScanner(reader)
+	for lines.Scan() {
+		line, number := lines.Text(), lines.Line()
+		name, params, value, err := parseContentLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("date.LoadICalendar: line %d: %w", number, err)
+		}
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			current = &event{line: number}
+		case current == nil:
+			continue
+		case name == "BEGIN":
+			current.depth++
+		case name == "END" && current.depth > 0:
+			current.depth--
+		case current.depth > 0:
+			// Properties of nested components, such as the SUMMARY or DURATION of a VALARM, do not describe the event.
+			continue
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			if current.start.IsZero() {
+				return nil, fmt.Errorf("date.LoadICalendar: line %d: event without DTSTART", current.line)
+			}
+			if current.skip || !current.allDay {
+				current = nil
+				continue
+			}
+			end := current.end
+			if end.IsZero() {
+				end = current.start.AddPeriod(current.duration)
+				if current.endLine == 0 {
+					end = current.start.AddDays(1)
+				}
+			}
+			if !end.After(current.start) {
+				return nil, fmt.Errorf("date.LoadICalendar: line %d: event ends on or before its start", current.endLine)
+			}
+			for day := range NewHalfOpenRange(current.start, end).Days() {
+				rules = append(rules, DateHoliday(current.summary, day))
+			}
+			current = nil
+		case name == "SUMMARY":
+			current.summary = unescapeText(value)
+		case name == "STATUS":
+			current.skip = strings.EqualFold(value, "CANCELLED")
+		case name == "DTSTART" || name == "DTEND":
+			date, allDay, err := parseICalendarDate(params, value)
+			if err != nil {
+				return nil, fmt.Errorf("date.LoadICalendar: line %d: %s: %w", number, name, err)
+			}
+			if name == "DTSTART" {
+				current.start, current.allDay = date, allDay
+			} else {
+				current.end, current.endLine = date, number
+			}
+		case name == "DURATION":
+			duration, err := ParsePeriod(value)
+			if err != nil {
+				return nil, fmt.Errorf("date.LoadICalendar: line %d: DURATION: %w", number, err)
+			}
+			current.duration, current.endLine = duration, number
+		case name == "RRULE" || name == "RDATE":
+			return nil, fmt.Errorf("date.LoadICalendar: line %d: recurring events are not supported", number)
+		}
+	}
+	if err := lines.Err(); err != nil {
+		return nil, fmt.Errorf("date.LoadICalendar: %w", err)
+	}
+	if current != nil {
+		return nil, fmt.Errorf("date.LoadICalendar: line %d: event is not terminated with END:VEVENT", current.line)
+	}
+	return NewHolidays(rules...), nil
+}
+
+// Scans the content lines of an iCalendar stream, joining folded lines and counting physical lines.
+type contentLineScanner struct {
+	scanner *bufio.Scanner
+	next    string
+	line    int
+	current string
+	start   int
+	pending bool
+	done    bool
+}
+
+func newContentLineScanner(reader io.Reader) *contentLineScanner {
+	return &contentLineScanner{scanner: bufio.NewScanner(reader)}
+}
+
+// Advances to the next content line. It reports false at the end of the input.
+func (scanner *contentLineScanner) Scan() bool {
+	if !scanner.pending && !scanner.advance() {
+		return false
+	}
+	scanner.current, scanner.start = scanner.next, scanner.line
+	scanner.pending = false
+	for scanner.advance() {
+		if scanner.next != "" && (scanner.next[0] == ' ' || scanner.next[0] == '\t') {
+			scanner.current += scanner.next[1:]
+			continue
+		}
+		scanner.pending = true
+		break
+	}
+	return true
+}
+
+// Reads the next non-empty physical line into next.
+func (scanner *contentLineScanner) advance() bool {
+	for !scanner.done && scanner.scanner.Scan() {
+		scanner.line++
+		scanner.next = strings.TrimRight(scanner.scanner.Text(), "\r")
+		if scanner.next != "" {
+			return true
+		}
+	}
+	scanner.done = true
+	return false
+}
+
+// Returns the current content line.
+func (scanner *contentLineScanner) Text() string {
+	return scanner.current
+}
+
+// Returns the physical line number at which the current content line starts.
+func (scanner *contentLineScanner) Line() int {
+	return scanner.start
+}
+
+// Returns the first error encountered while reading.
+func (scanner *contentLineScanner) Err() error {
+	return scanner.scanner.Err()
+}
+
+// Splits an iCalendar content line into its upper-cased name, its parameters, and its value.
+func parseContentLine(line string) (name string, params map[string]string, value string, err error) {
+	// The parameters are separated by semicolons and the value starts at the first colon, outside quoted parameter values.
+	var parts []string
+	quoted := false
+	start, colon := 0, -1
+	for i := 0; i < len(line) && colon < 0; i++ {
+		switch line[i] {
+		case '"':
+			quoted = !quoted
+		case ';', ':':
+			if quoted {
+				continue
+			}
+			parts = append(parts, line[start:i])
+			start = i + 1
+			if line[i] == ':' {
+				colon = i
+			}
+		}
+	}
+	if colon < 0 {
+		return "", nil, "", fmt.Errorf("invalid content line %q", line)
+	}
+	value = line[colon+1:]
+	name = strings.ToUpper(parts[0])
+	params = map[string]string{}
+	for _, param := range parts[1:] {
+		key, val, _ := strings.Cut(param, "=")
+		params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+	}
+	return name, params, value, nil
+}
+
+// Parses a DTSTART or DTEND value, reporting whether it is a date rather than a date-time.
+func parseICalendarDate(params map[string]string, value string) (date Date, allDay bool, err error) {
+	allDay = strings.EqualFold(params["VALUE"], "DATE") || len(value) == 8
+	if len(value) < 8 {
+		return Date{}, false, fmt.Errorf("invalid date %q", value)
+	}
+	date, err = Parse("20060102", value[:8])
+	if err != nil {
+		return Date{}, false, fmt.Errorf("invalid date %q", value)
+	}
+	return date, allDay, nil
+}
+
+// Unescapes an iCalendar TEXT value.
+func unescapeText(value string) string {
+	return strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
+
+// A holiday rule in a definition file.
+type holidayDefinition struct {
+	Name           string     `yaml:"name"`
+	Date           string     `yaml:"date"`
+	Month          string     `yaml:"month"`
+	Day            *int       `yaml:"day"`
+	Weekday        string     `yaml:"weekday"`
+	Nth            int        `yaml:"nth"`
+	Easter         *int       `yaml:"easter"`
+	OrthodoxEaster *int       `yaml:"orthodox_easter"`
+	From           int        `yaml:"from"`
+	To             int        `yaml:"to"`
+	Observed       Observance `yaml:"observed"`
+}
+
+var holidayDefinitionKeys = []string{"name", "date", "month", "day", "weekday", "nth", "easter", "orthodox_easter", "from", "to", "observed"}
+
+// Loads holidays from a JSON or YAML definition file.
+//
+// # Parameters
+//
+//	reader io.Reader
+//
+// The JSON or YAML definitions.
+//
+// # Returns
+//
+//	holidays *Holidays
+//
+// The holidays computed from the definitions.
+//
+//	err error
+//
+// An error if the definitions cannot be parsed. The error reports the line of the offending definition.
+//
+// # Remarks
+//
+// The file contains a holidays list, and each entry defines one rule with the following keys:
+//
+//	name             the name of the holiday (required)
+//	date             a one-off holiday, YYYY-MM-DD
+//	month, day       a holiday on the same day every year
+//	month, weekday, nth
+//	                 the nth weekday of the month; a negative nth counts from the end of the month
+//	month, day, weekday
+//	                 the first weekday on or after the month and day
+//	easter           the number of days from Western Easter Sunday
+//	orthodox_easter  the number of days from Orthodox Easter Sunday
+//	from, to         the first and last year in which the holiday is celebrated (optional)
+//	observed         not-observed, sunday-to-monday, weekend-to-monday, nearest-weekday, or next-free-weekday (optional)
+//
+// Months and weekdays are given by number (1 for January, 0 for Sunday) or by English name.
+// For example:
+//
+//	holidays:
+//	  - name: New Year's Day
+//	    month: January
+//	    day: 1
+//	    observed: nearest-weekday
+//	  - name: Good Friday
+//	    easter: -2
+//	  - name: Juneteenth
+//	    month: June
+//	    day: 19
+//	    from: 2022
+//
+// Since JSON is a subset of YAML, the same structure can be written as a JSON object.
+func LoadHolidayDefinitions(reader io.Reader) (holidays *Holidays, err error) {
+	var document yaml.Node
+	if err := yaml.NewDecoder(reader).Decode(&document); err != nil {
+		if err == io.EOF {
+			return NewHolidays(), nil
+		}
+		return nil, fmt.Errorf("date.LoadHolidayDefinitions: %w", err)
+	}
+	root := &document
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("date.LoadHolidayDefinitions: line %d: expected a mapping with a holidays list", root.Line)
+	}
+	var list *yaml.Node
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if key := root.Content[i]; key.Value == "holidays" {
+			list = root.Content[i+1]
+		} else {
+			return nil, fmt.Errorf("date.LoadHolidayDefinitions: line %d: unknown key %q", key.Line, key.Value)
+		}
+	}
+	if list == nil {
+		return NewHolidays(), nil
+	}
+	if list.Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("date.LoadHolidayDefinitions: line %d: holidays must be a list", list.Line)
+	}
+	rules := make([]HolidayRule, 0, len(list.Content))
+	for _, item := range list.Content {
+		rule, err := parseHolidayDefinition(item)
+		if err != nil {
+			return nil, fmt.Errorf("date.LoadHolidayDefinitions: line %d: %w", item.Line, err)
+		}
+		rules = append(rules, rule)
+	}
+	return NewHolidays(rules...), nil
+}
+
+func parseHolidayDefinition(node *yaml.Node) (rule HolidayRule, err error) {
+	if node.Kind != yaml.MappingNode {
+		return HolidayRule{}, errors.New("holiday definition must be a mapping")
+	}
+	for i := 0; i < len(node.Content); i += 2 {
+		if key := node.Content[i].Value; !slices.Contains(holidayDefinitionKeys, key) {
+			return HolidayRule{}, fmt.Errorf("unknown key %q", key)
+		}
+	}
+	var definition holidayDefinition
+	if err := node.Decode(&definition); err != nil {
+		return HolidayRule{}, err
+	}
+	if definition.Name == "" {
+		return HolidayRule{}, errors.New("missing name")
+	}
+	var month time.Month
+	if definition.Month != "" {
+		if month, err = parseMonthName(definition.Month); err != nil {
+			return HolidayRule{}, err
+		}
+	}
+	var weekday time.Weekday
+	if definition.Weekday != "" {
+		if weekday, err = parseWeekdayName(definition.Weekday); err != nil {
+			return HolidayRule{}, err
+		}
+	}
+	switch {
+	case definition.Date != "":
+		date, err := Parse(time.DateOnly, definition.Date)
+		if err != nil {
+			return HolidayRule{}, fmt.Errorf("invalid date %q", definition.Date)
+		}
+		return DateHoliday(definition.Name, date).Observed(definition.Observed), nil
+	case definition.Easter != nil:
+		rule = EasterHoliday(definition.Name, *definition.Easter)
+	case definition.OrthodoxEaster != nil:
+		rule = OrthodoxEasterHoliday(definition.Name, *definition.OrthodoxEaster)
+	case month == 0:
+		return HolidayRule{}, fmt.Errorf("holiday %q needs one of date, month, easter, and orthodox_easter", definition.Name)
+	case definition.Weekday != "" && definition.Nth != 0:
+		rule = NthWeekdayHoliday(definition.Name, month, weekday, definition.Nth)
+	case definition.Day == nil:
+		return HolidayRule{}, fmt.Errorf("holiday %q needs day, or weekday and nth", definition.Name)
+	case *definition.Day < 1 || *definition.Day > 31:
+		return HolidayRule{}, fmt.Errorf("invalid day %d", *definition.Day)
+	case definition.Weekday != "":
+		rule = WeekdayOnOrAfterHoliday(definition.Name, month, *definition.Day, weekday)
+	default:
+		rule = FixedHoliday(definition.Name, month, *definition.Day)
+	}
+	return rule.Between(definition.From, definition.To).Observed(definition.Observed), nil
+}
+
+// Parses a month given by number (1 through 12) or by English name.
+func parseMonthName(value string) (month time.Month, err error) {
+	if n, err := strconv.Atoi(value); err == nil {
+		if n < 1 || n > 12 {
+			return 0, fmt.Errorf("invalid month %q", value)
+		}
+		return time.Month(n), nil
+	}
+	for m := time.January; m <= time.December; m++ {
+		if strings.EqualFold(value, m.String()) || strings.EqualFold(value, m.String()[:3]) {
+			return m, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid month %q", value)
+}
+
+// Parses a day of the week given by number (0 for Sunday through 6) or by English name.
+func parseWeekdayName(value string) (weekday time.Weekday, err error) {
+	if n, err := strconv.Atoi(value); err == nil {
+		if n < 0 || n > 6 {
+			return 0, fmt.Errorf("invalid weekday %q", value)
+		}
+		return time.Weekday(n), nil
+	}
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(value, d.String()) || strings.EqualFold(value, d.String()[:3]) {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday %q", value)
+}
@@ -0,0 +1,287 @@
+package date
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+const exchangeCalendar = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Exchange//Holidays//EN\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:1\r\n" +
+	"DTSTART;VALUE=DATE:20241225\r\n" +
+	"SUMMARY:Christmas\\, closed\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:2\r\n" +
+	"DTSTART;VALUE=DATE:20241230\r\n" +
+	"DTEND;VALUE=DATE:20250102\r\n" +
+	"SUMMARY:Year-end\r\n" +
+	"  closure\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:3\r\n" +
+	"DTSTART;TZID=America/New_York:20241224T130000\r\n" +
+	"SUMMARY:Early close\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:4\r\n" +
+	"DTSTART;VALUE=DATE:20241127\r\n" +
+	"STATUS:CANCELLED\r\n" +
+	"SUMMARY:Cancelled\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:5\r\n" +
+	"DTSTART;VALUE=DATE:20240704\r\n" +
+	"SUMMARY:Independence Day\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"TRIGGER:-PT15M\r\n" +
+	"DURATION:PT15M\r\n" +
+	"REPEAT:1\r\n" +
+	"SUMMARY:Reminder\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestLoadICalendar(t *testing.T) {
+	holidays, err := LoadICalendar(strings.NewReader(exchangeCalendar))
+	if err != nil {
+		t.Fatalf("LoadICalendar() error = %v", err)
+	}
+	tests := []struct {
+		name     string
+		date     Date
+		wantName string
+		wantOk   bool
+	}{
+		{name: "Single day", date: New(2024, time.December, 25), wantName: "Christmas, closed", wantOk: true},
+		{name: "Multi-day - first", date: New(2024, time.December, 30), wantName: "Year-end closure", wantOk: true},
+		{name: "Multi-day - across year", date: New(2025, time.January, 1), wantName: "Year-end closure", wantOk: true},
+		{name: "Multi-day - exclusive end", date: New(2025, time.January, 2), wantOk: false},
+		{name: "Timed event", date: New(2024, time.December, 24), wantOk: false},
+		{name: "Cancelled event", date: New(2024, time.November, 27), wantOk: false},
+		{name: "Event with an alarm", date: New(2024, time.July, 4), wantName: "Independence Day", wantOk: true},
+		{name: "Event with an alarm - one day", date: New(2024, time.July, 5), wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, ok := tt.date.IsHoliday(holidays)
+			if name != tt.wantName || ok != tt.wantOk {
+				t.Errorf("Date.IsHoliday() = %q, %v, want %q, %v", name, ok, tt.wantName, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestParseContentLine(t *testing.T) {
+	name, params, value, err := parseContentLine(`DTSTART;TZID="Europe/Warsaw;Summer:Time";VALUE=DATE-TIME:20241224T130000`)
+	if err != nil || name != "DTSTART" || value != "20241224T130000" || params["TZID"] != "Europe/Warsaw;Summer:Time" || params["VALUE"] != "DATE-TIME" {
+		t.Errorf("parseContentLine() = %q, %q, %q, %v", name, params, value, err)
+	}
+	name, params, value, err = parseContentLine(`SUMMARY;ALTREP="http://example.com/a;b":Holiday: closed`)
+	if err != nil || name != "SUMMARY" || value != "Holiday: closed" || params["ALTREP"] != "http://example.com/a;b" {
+		t.Errorf("parseContentLine() = %q, %q, %q, %v", name, params, value, err)
+	}
+}
+
+func TestLoadICalendar_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name:    "Invalid date",
+			data:    "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:2024-12-25\nEND:VEVENT\nEND:VCALENDAR\n",
+			wantErr: "line 3",
+		},
+		{
+			name:    "Recurring event",
+			data:    "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20241225\nRRULE:FREQ=YEARLY\nEND:VEVENT\nEND:VCALENDAR\n",
+			wantErr: "line 4",
+		},
+		{
+			name:    "Unterminated event",
+			data:    "BEGIN:VCALENDAR\n\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20241225\n",
+			wantErr: "line 3",
+		},
+		{
+			name:    "End before start",
+			data:    "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20241225\nDTEND;VALUE=DATE:20241224\nEND:VEVENT\nEND:VCALENDAR\n",
+			wantErr: "line 4: event ends on or before its start",
+		},
+		{
+			name:    "End on start",
+			data:    "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20241225\nSUMMARY:Christmas\nDTEND;VALUE=DATE:20241225\nEND:VEVENT\nEND:VCALENDAR\n",
+			wantErr: "line 5: event ends on or before its start",
+		},
+		{
+			name:    "Zero duration",
+			data:    "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20241225\nDURATION:P0D\nEND:VEVENT\nEND:VCALENDAR\n",
+			wantErr: "line 4: event ends on or before its start",
+		},
+		{
+			name:    "Event without DTSTART",
+			data:    "BEGIN:VCALENDAR\nBEGIN:VEVENT\nSUMMARY:Christmas\nEND:VEVENT\nEND:VCALENDAR\n",
+			wantErr: "line 2: event without DTSTART",
+		},
+		{
+			name:    "Invalid content line",
+			data:    "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART\n",
+			wantErr: "line 3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadICalendar(strings.NewReader(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("LoadICalendar() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+const companyHolidays = `# Company holidays
+holidays:
+  - name: New Year's Day
+    month: January
+    day: 1
+    observed: nearest-weekday
+  - name: Good Friday
+    easter: -2
+  - name: Thanksgiving
+    month: 11
+    weekday: thursday
+    nth: 4
+  - name: Day after Thanksgiving
+    month: November
+    day: 23
+    weekday: Friday
+  - name: Juneteenth
+    month: June
+    day: 19
+    from: 2022
+  - name: Company anniversary
+    date: 2025-09-01
+`
+
+func TestLoadHolidayDefinitions(t *testing.T) {
+	jsonHolidays := `{"holidays": [
+		{"name": "New Year's Day", "month": 1, "day": 1, "observed": "nearest-weekday"},
+		{"name": "Good Friday", "easter": -2}
+	]}`
+	for name, data := range map[string]string{"YAML": companyHolidays, "JSON": jsonHolidays} {
+		t.Run(name, func(t *testing.T) {
+			holidays, err := LoadHolidayDefinitions(strings.NewReader(data))
+			if err != nil {
+				t.Fatalf("LoadHolidayDefinitions() error = %v", err)
+			}
+			want := []Holiday{
+				{Name: "Good Friday", Date: New(2021, time.April, 2)},
+				{Name: "New Year's Day", Date: New(2021, time.December, 31), Observed: true},
+			}
+			got := slices.DeleteFunc(holidays.InYear(2021), func(holiday Holiday) bool {
+				return !slices.Contains([]string{"Good Friday", "New Year's Day"}, holiday.Name) || holiday.Date.Month() == time.January
+			})
+			if !slices.Equal(got, want) {
+				t.Errorf("Holidays.InYear() = %v, want %v", got, want)
+			}
+		})
+	}
+	holidays, err := LoadHolidayDefinitions(strings.NewReader(companyHolidays))
+	if err != nil {
+		t.Fatalf("LoadHolidayDefinitions() error = %v", err)
+	}
+	tests := []struct {
+		name     string
+		date     Date
+		wantName string
+		wantOk   bool
+	}{
+		{name: "Nth weekday", date: New(2024, time.November, 28), wantName: "Thanksgiving", wantOk: true},
+		{name: "Weekday on or after", date: New(2024, time.November, 29), wantName: "Day after Thanksgiving", wantOk: true},
+		{name: "Before valid", date: New(2021, time.June, 19), wantOk: false},
+		{name: "Valid", date: New(2022, time.June, 19), wantName: "Juneteenth", wantOk: true},
+		{name: "One-off", date: New(2025, time.September, 1), wantName: "Company anniversary", wantOk: true},
+		{name: "One-off - other year", date: New(2026, time.September, 1), wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, ok := tt.date.IsHoliday(holidays)
+			if name != tt.wantName || ok != tt.wantOk {
+				t.Errorf("Date.IsHoliday() = %q, %v, want %q, %v", name, ok, tt.wantName, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestLoadHolidayDefinitions_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name:    "Unknown key",
+			data:    "holidays:\n  - name: A\n    month: 1\n    day: 1\n  - name: B\n    mont: 2\n",
+			wantErr: "line 5: unknown key \"mont\"",
+		},
+		{
+			name:    "Invalid month",
+			data:    "holidays:\n  - name: A\n    month: Smarch\n    day: 1\n",
+			wantErr: "line 2: invalid month",
+		},
+		{
+			name:    "Invalid observance",
+			data:    "holidays:\n  - name: A\n    month: 1\n    day: 1\n    observed: sometimes\n",
+			wantErr: "line 2",
+		},
+		{
+			name:    "Missing rule",
+			data:    "holidays:\n  - name: A\n",
+			wantErr: "line 2",
+		},
+		{
+			name:    "Syntax error",
+			data:    "holidays:\n  - name: A\n   bad indentation: [\n",
+			wantErr: "line",
+		},
+		{
+			name:    "JSON",
+			data:    "{\"holidays\": [\n{\"name\": \"A\", \"easter\": \"x\"}\n]}",
+			wantErr: "line 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadHolidayDefinitions(strings.NewReader(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("LoadHolidayDefinitions() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadHolidaysFile(t *testing.T) {
+	directory := t.TempDir()
+	ics := filepath.Join(directory, "exchange.ics")
+	if err := os.WriteFile(ics, []byte(exchangeCalendar), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if holidays, err := LoadHolidaysFile(ics); err != nil || !holidays.Contains(New(2024, time.December, 25)) {
+		t.Errorf("LoadHolidaysFile(%q) = %v, %v", ics, holidays, err)
+	}
+	yml := filepath.Join(directory, "company.yaml")
+	if err := os.WriteFile(yml, []byte("holidays:\n  - name: A\n    month: 13\n    day: 1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadHolidaysFile(yml); err == nil || !strings.Contains(err.Error(), "company.yaml") || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("LoadHolidaysFile(%q) error = %v, want file name and line", yml, err)
+	}
+}
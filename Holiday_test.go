@@ -0,0 +1,217 @@
+package date
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEaster(t *testing.T) {
+	tests := []struct {
+		name         string
+		year         int
+		wantWestern  Date
+		wantOrthodox Date
+	}{
+		{
+			name:         "2000",
+			year:         2000,
+			wantWestern:  New(2000, time.April, 23),
+			wantOrthodox: New(2000, time.April, 30),
+		},
+		{
+			name:         "2021",
+			year:         2021,
+			wantWestern:  New(2021, time.April, 4),
+			wantOrthodox: New(2021, time.May, 2),
+		},
+		{
+			name:         "2024",
+			year:         2024,
+			wantWestern:  New(2024, time.March, 31),
+			wantOrthodox: New(2024, time.May, 5),
+		},
+		{
+			name:         "2025 - same day",
+			year:         2025,
+			wantWestern:  New(2025, time.April, 20),
+			wantOrthodox: New(2025, time.April, 20),
+		},
+		{
+			name:         "Earliest possible",
+			year:         2285,
+			wantWestern:  New(2285, time.March, 22),
+			wantOrthodox: New(2285, time.April, 26),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Easter(tt.year); got != tt.wantWestern {
+				t.Errorf("Easter() = %v, want %v", got, tt.wantWestern)
+			}
+			if got := OrthodoxEaster(tt.year); got != tt.wantOrthodox {
+				t.Errorf("OrthodoxEaster() = %v, want %v", got, tt.wantOrthodox)
+			}
+		})
+	}
+}
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	type args struct {
+		year    int
+		month   time.Month
+		weekday time.Weekday
+		n       int
+	}
+	tests := []struct {
+		name   string
+		args   args
+		want   Date
+		wantOk bool
+	}{
+		{
+			name:   "Fourth Thursday of November",
+			args:   args{year: 2024, month: time.November, weekday: time.Thursday, n: 4},
+			want:   New(2024, time.November, 28),
+			wantOk: true,
+		},
+		{
+			name:   "Last Monday of May",
+			args:   args{year: 2024, month: time.May, weekday: time.Monday, n: -1},
+			want:   New(2024, time.May, 27),
+			wantOk: true,
+		},
+		{
+			name:   "First Monday - first day of month",
+			args:   args{year: 2024, month: time.January, weekday: time.Monday, n: 1},
+			want:   New(2024, time.January, 1),
+			wantOk: true,
+		},
+		{
+			name:   "Last Saturday - last day of month",
+			args:   args{year: 2024, month: time.August, weekday: time.Saturday, n: -1},
+			want:   New(2024, time.August, 31),
+			wantOk: true,
+		},
+		{
+			name:   "Fifth Monday of February",
+			args:   args{year: 2024, month: time.February, weekday: time.Monday, n: 5},
+			wantOk: false,
+		},
+		{
+			name:   "Zero",
+			args:   args{year: 2024, month: time.February, weekday: time.Monday, n: 0},
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NthWeekdayOfMonth(tt.args.year, tt.args.month, tt.args.weekday, tt.args.n)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("NthWeekdayOfMonth() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestHolidays_Validity(t *testing.T) {
+	holidays := NewHolidays(
+		FixedHoliday("Nowy Rok", time.January, 1),
+		FixedHoliday("Święto Trzech Króli", time.January, 6).Between(2011, 0),
+		EasterHoliday("Boże Ciało", 60),
+	)
+	tests := []struct {
+		name     string
+		date     Date
+		wantName string
+		wantOk   bool
+	}{
+		{name: "Fixed", date: New(2010, time.January, 1), wantName: "Nowy Rok", wantOk: true},
+		{name: "Before valid", date: New(2010, time.January, 6), wantOk: false},
+		{name: "Valid", date: New(2011, time.January, 6), wantName: "Święto Trzech Króli", wantOk: true},
+		{name: "Easter offset", date: New(2024, time.May, 30), wantName: "Boże Ciało", wantOk: true},
+		{name: "Not a holiday", date: New(2024, time.May, 31), wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := holidays.Lookup(tt.date)
+			if got.Name != tt.wantName || ok != tt.wantOk {
+				t.Errorf("Holidays.Lookup() = %q, %v, want %q, %v", got.Name, ok, tt.wantName, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestHolidays_Observance(t *testing.T) {
+	tests := []struct {
+		name     string
+		holidays *Holidays
+		year     int
+		want     []Holiday
+	}{
+		{
+			name:     "Sunday to Monday",
+			holidays: NewHolidays(FixedHoliday("Independence Day", time.July, 4).Observed(NearestWeekday)),
+			year:     2021,
+			want: []Holiday{
+				{Name: "Independence Day", Date: New(2021, time.July, 4)},
+				{Name: "Independence Day", Date: New(2021, time.July, 5), Observed: true},
+			},
+		},
+		{
+			name:     "Across year boundary",
+			holidays: NewHolidays(FixedHoliday("New Year's Day", time.January, 1).Observed(NearestWeekday)),
+			year:     2021,
+			want: []Holiday{
+				{Name: "New Year's Day", Date: New(2021, time.January, 1)},
+				{Name: "New Year's Day", Date: New(2021, time.December, 31), Observed: true},
+			},
+		},
+		{
+			name: "Substitute days",
+			holidays: NewHolidays(
+				FixedHoliday("Christmas Day", time.December, 25).Observed(NextFreeWeekday),
+				FixedHoliday("Boxing Day", time.December, 26).Observed(NextFreeWeekday),
+			),
+			year: 2021,
+			want: []Holiday{
+				{Name: "Christmas Day", Date: New(2021, time.December, 25)},
+				{Name: "Boxing Day", Date: New(2021, time.December, 26)},
+				{Name: "Christmas Day", Date: New(2021, time.December, 27), Observed: true},
+				{Name: "Boxing Day", Date: New(2021, time.December, 28), Observed: true},
+			},
+		},
+		{
+			name:     "Weekday - not shifted",
+			holidays: NewHolidays(FixedHoliday("Independence Day", time.July, 4).Observed(WeekendToMonday)),
+			year:     2024,
+			want: []Holiday{
+				{Name: "Independence Day", Date: New(2024, time.July, 4)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.holidays.InYear(tt.year); !slices.Equal(got, tt.want) {
+				t.Errorf("Holidays.InYear() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHolidays_Concurrent(t *testing.T) {
+	holidays := NewHolidays(EasterHoliday("Easter Monday", 1))
+	calendar := NewBusinessCalendar(SaturdaySunday, holidays.Contains)
+	var wait sync.WaitGroup
+	for year := 2000; year < 2050; year++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			if Easter(year).AddDays(1).IsBusinessDay(calendar) {
+				t.Errorf("Easter Monday %d is a business day", year)
+			}
+		}()
+	}
+	wait.Wait()
+}
@@ -0,0 +1,459 @@
+package date
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Represents the month and weekday names and the default layouts of a language.
+//
+// # Remarks
+//
+// Layouts use the reference time of the time package. The English names January, Jan, Monday, and Mon in a layout
+// are replaced by the names of the locale, so a locale only supplies names and layouts, not its own layout syntax.
+type Locale struct {
+	// The BCP 47 language tag, for example pl or en-GB.
+	Tag string
+	// The names of the months in the nominative case, January first, as in a calendar heading.
+	Months [12]string
+	// The names of the months in the genitive case, used when a layout also contains the day of the month,
+	// as in the Polish 18 października 2026. Empty if the language does not inflect month names.
+	MonthsGenitive [12]string
+	// The abbreviated names of the months, January first.
+	ShortMonths [12]string
+	// The names of the days of the week, Sunday first, so that they are indexed by time.Weekday.
+	Weekdays [7]string
+	// The abbreviated names of the days of the week, Sunday first.
+	ShortWeekdays [7]string
+	// The numeric layout, for example 18.10.2026.
+	ShortLayout string
+	// The layout with an abbreviated month name or, where the language prefers it, a numeric layout.
+	MediumLayout string
+	// The layout with the full month name, for example 18 października 2026.
+	LongLayout string
+	// The layout with the full month and weekday names, for example niedziela, 18 października 2026.
+	FullLayout string
+}
+
+// The built-in locales, returned as copies so that callers cannot change them for one another.
+var (
+	polish = Locale{
+		Tag:            "pl",
+		Months:         [12]string{"styczeń", "luty", "marzec", "kwiecień", "maj", "czerwiec", "lipiec", "sierpień", "wrzesień", "październik", "listopad", "grudzień"},
+		MonthsGenitive: [12]string{"stycznia", "lutego", "marca", "kwietnia", "maja", "czerwca", "lipca", "sierpnia", "września", "października", "listopada", "grudnia"},
+		ShortMonths:    [12]string{"sty", "lut", "mar", "kwi", "maj", "cze", "lip", "sie", "wrz", "paź", "lis", "gru"},
+		Weekdays:       [7]string{"niedziela", "poniedziałek", "wtorek", "środa", "czwartek", "piątek", "sobota"},
+		ShortWeekdays:  [7]string{"niedz.", "pon.", "wt.", "śr.", "czw.", "pt.", "sob."},
+		ShortLayout:    "02.01.2006",
+		MediumLayout:   "2 Jan 2006",
+		LongLayout:     "2 January 2006",
+		FullLayout:     "Monday, 2 January 2006",
+	}
+	german = Locale{
+		Tag:           "de",
+		Months:        [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		ShortMonths:   [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+		Weekdays:      [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		ShortWeekdays: [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		ShortLayout:   "02.01.06",
+		MediumLayout:  "02.01.2006",
+		LongLayout:    "2. January 2006",
+		FullLayout:    "Monday, 2. January 2006",
+	}
+	french = Locale{
+		Tag:           "fr",
+		Months:        [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		ShortMonths:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		Weekdays:      [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		ShortWeekdays: [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		ShortLayout:   "02/01/2006",
+		MediumLayout:  "2 Jan 2006",
+		LongLayout:    "2 January 2006",
+		FullLayout:    "Monday 2 January 2006",
+	}
+	spanish = Locale{
+		Tag:           "es",
+		Months:        [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		ShortMonths:   [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+		Weekdays:      [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		ShortWeekdays: [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		ShortLayout:   "2/1/06",
+		MediumLayout:  "2 Jan 2006",
+		LongLayout:    "2 de January de 2006",
+		FullLayout:    "Monday, 2 de January de 2006",
+	}
+	britishEnglish = Locale{
+		Tag:           "en-GB",
+		Months:        englishMonths,
+		ShortMonths:   englishShortMonths,
+		Weekdays:      englishWeekdays,
+		ShortWeekdays: englishShortWeekdays,
+		ShortLayout:   "02/01/2006",
+		MediumLayout:  "2 Jan 2006",
+		LongLayout:    "2 January 2006",
+		FullLayout:    "Monday, 2 January 2006",
+	}
+	americanEnglish = Locale{
+		Tag:           "en-US",
+		Months:        englishMonths,
+		ShortMonths:   englishShortMonths,
+		Weekdays:      englishWeekdays,
+		ShortWeekdays: englishShortWeekdays,
+		ShortLayout:   "1/2/06",
+		MediumLayout:  "Jan 2, 2006",
+		LongLayout:    "January 2, 2006",
+		FullLayout:    "Monday, January 2, 2006",
+	}
+)
+
+var (
+	englishMonths        = [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}
+	englishShortMonths   = [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"}
+	englishWeekdays      = [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+	englishShortWeekdays = [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+)
+
+// Returns the Polish locale.
+//
+// # Returns
+//
+//	locale *Locale
+//
+// A new copy of the locale, which the caller may modify.
+func Polish() (locale *Locale) {
+	return clone(&polish)
+}
+
+// Returns the German locale.
+//
+// # Returns
+//
+//	locale *Locale
+//
+// A new copy of the locale, which the caller may modify.
+func German() (locale *Locale) {
+	return clone(&german)
+}
+
+// Returns the French locale.
+//
+// # Returns
+//
+//	locale *Locale
+//
+// A new copy of the locale, which the caller may modify.
+func French() (locale *Locale) {
+	return clone(&french)
+}
+
+// Returns the Spanish locale.
+//
+// # Returns
+//
+//	locale *Locale
+//
+// A new copy of the locale, which the caller may modify.
+func Spanish() (locale *Locale) {
+	return clone(&spanish)
+}
+
+// Returns the British English locale.
+//
+// # Returns
+//
+//	locale *Locale
+//
+// A new copy of the locale, which the caller may modify.
+func BritishEnglish() (locale *Locale) {
+	return clone(&britishEnglish)
+}
+
+// Returns the American English locale.
+//
+// # Returns
+//
+//	locale *Locale
+//
+// A new copy of the locale, which the caller may modify.
+func AmericanEnglish() (locale *Locale) {
+	return clone(&americanEnglish)
+}
+
+// Returns a new copy of locale.
+func clone(locale *Locale) *Locale {
+	copy := *locale
+	return &copy
+}
+
+var locales = map[string]*Locale{
+	"pl":    &polish,
+	"de":    &german,
+	"fr":    &french,
+	"es":    &spanish,
+	"en-gb": &britishEnglish,
+	"en-us": &americanEnglish,
+	"en":    &americanEnglish,
+}
+
+// Returns the locale of a language tag.
+//
+// # Parameters
+//
+//	tag string
+//
+// The BCP 47 language tag, for example pl, de-AT, or en_GB. Case is ignored and underscores are read as hyphens.
+//
+// # Returns
+//
+//	locale *Locale
+//
+// A new copy of the locale of tag or, if there is none, of its language; en selects en-US.
+//
+//	err error
+//
+// An error if there is no locale for tag or its language.
+//
+// # Remarks
+//
+// The supported tags are pl, de, fr, es, en-GB, and en-US.
+func LookupLocale(tag string) (locale *Locale, err error) {
+	key := strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+	if locale, ok := locales[key]; ok {
+		return clone(locale), nil
+	}
+	language, _, _ := strings.Cut(key, "-")
+	if locale, ok := locales[language]; ok {
+		return clone(locale), nil
+	}
+	return nil, fmt.Errorf("date.LookupLocale: unknown locale %q", tag)
+}
+
+// Returns the tags of the locales accepted by [LookupLocale].
+//
+// # Returns
+//
+//	tags []string
+//
+// The sorted tags.
+func LocaleTags() (tags []string) {
+	for _, locale := range locales {
+		if !slices.Contains(tags, locale.Tag) {
+			tags = append(tags, locale.Tag)
+		}
+	}
+	slices.Sort(tags)
+	return tags
+}
+
+// The month and weekday elements of a layout.
+type layoutName uint8
+
+const (
+	noName layoutName = iota
+	longMonthName
+	shortMonthName
+	longWeekdayName
+	shortWeekdayName
+)
+
+// Splits layout at its first month or weekday name element, recognized as by the time package.
+func nextLayoutName(layout string) (prefix string, name layoutName, suffix string) {
+	for i := 0; i < len(layout); i++ {
+		switch rest := layout[i:]; {
+		case strings.HasPrefix(rest, "January"):
+			return layout[:i], longMonthName, rest[7:]
+		case strings.HasPrefix(rest, "Jan"):
+			return layout[:i], shortMonthName, rest[3:]
+		case strings.HasPrefix(rest, "Monday"):
+			return layout[:i], longWeekdayName, rest[6:]
+		case strings.HasPrefix(rest, "Mon") && (len(rest) == 3 || rest[3] < 'a' || rest[3] > 'z'):
+			return layout[:i], shortWeekdayName, rest[3:]
+		}
+	}
+	return layout, noName, ""
+}
+
+// Reports whether layout contains a day of the month element, 2, _2, or 02.
+func hasDayOfMonth(layout string) bool {
+	for i := 0; i < len(layout); i++ {
+		switch rest := layout[i:]; {
+		case strings.HasPrefix(rest, "2006"):
+			i += 3
+		case strings.HasPrefix(rest, "002"), strings.HasPrefix(rest, "__2"):
+			i += 2
+		case rest[0] == '2':
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the names of locale matching a layout name element, indexed by month or weekday.
+func (locale *Locale) names(name layoutName) (names [][]string) {
+	switch name {
+	case longMonthName:
+		return [][]string{locale.Months[:], locale.MonthsGenitive[:]}
+	case shortMonthName:
+		return [][]string{locale.ShortMonths[:]}
+	case longWeekdayName:
+		return [][]string{locale.Weekdays[:]}
+	default:
+		return [][]string{locale.ShortWeekdays[:]}
+	}
+}
+
+// Returns the date formatted according to layout, with month and weekday names in the language of locale.
+//
+// # Parameters
+//
+//	layout string
+//
+// The layout, as for Format, or one of the default layouts of locale, for example locale.LongLayout.
+//
+//	locale *Locale
+//
+// The locale.
+//
+// # Returns
+//
+//	result string
+//
+// The formatted date.
+//
+// # Remarks
+//
+// The genitive month names of locale are used when layout also contains the day of the month,
+// so with Polish the layout "2 January 2006" gives 18 października 2026 and "January 2006" gives październik 2026.
+func (date Date) FormatLocale(layout string, locale *Locale) (result string) {
+	genitive := hasDayOfMonth(layout)
+	t := date.toTime()
+	var bytes []byte
+	for layout != "" {
+		prefix, name, suffix := nextLayoutName(layout)
+		bytes = t.AppendFormat(bytes, prefix)
+		switch name {
+		case longMonthName:
+			if month := t.Month() - 1; genitive && locale.MonthsGenitive[month] != "" {
+				bytes = append(bytes, locale.MonthsGenitive[month]...)
+			} else {
+				bytes = append(bytes, locale.Months[month]...)
+			}
+		case shortMonthName:
+			bytes = append(bytes, locale.ShortMonths[t.Month()-1]...)
+		case longWeekdayName:
+			bytes = append(bytes, locale.Weekdays[t.Weekday()]...)
+		case shortWeekdayName:
+			bytes = append(bytes, locale.ShortWeekdays[t.Weekday()]...)
+		}
+		layout = suffix
+	}
+	return string(bytes)
+}
+
+// Parses a date with month and weekday names in the language of a locale.
+//
+// # Parameters
+//
+//	layout string
+//
+// The layout, as for Parse, or one of the default layouts of locale, for example locale.LongLayout.
+//
+//	value string
+//
+// The date to parse.
+//
+//	locale *Locale
+//
+// The locale.
+//
+// # Returns
+//
+//	date Date
+//
+// The parsed date.
+//
+//	err error
+//
+// An error if value does not match layout.
+//
+// # Remarks
+//
+// Names are matched case-insensitively; a full month name may be in the nominative or the genitive case.
+// As with Parse, a weekday name is checked for syntax but not against the date.
+func ParseLocale(layout string, value string, locale *Locale) (date Date, err error) {
+	var builder strings.Builder
+	rest := value
+	for remaining := layout; remaining != ""; {
+		_, name, suffix := nextLayoutName(remaining)
+		if name == noName {
+			break
+		}
+		i, n, index := locale.findName(rest, name)
+		if i < 0 {
+			return Date{}, fmt.Errorf("date.ParseLocale: cannot parse %q as %q: missing month or weekday name of locale %s", value, layout, locale.Tag)
+		}
+		builder.WriteString(rest[:i])
+		builder.WriteString(englishName(name, index))
+		rest = rest[i+n:]
+		remaining = suffix
+	}
+	builder.WriteString(rest)
+	date, err = Parse(layout, builder.String())
+	if err != nil {
+		return Date{}, fmt.Errorf("date.ParseLocale: cannot parse %q as %q: %w", value, layout, err)
+	}
+	return date, nil
+}
+
+// Finds the first word of value that is a name of locale for a layout name element.
+// Returns its byte offset and length and its month or weekday index, or -1 if there is none.
+func (locale *Locale) findName(value string, name layoutName) (offset int, length int, index int) {
+	names := locale.names(name)
+	for i, r := range value {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		if previous, _ := utf8.DecodeLastRuneInString(value[:i]); i > 0 && unicode.IsLetter(previous) {
+			continue
+		}
+		if length, index := matchName(value[i:], names); index >= 0 {
+			return i, length, index
+		}
+	}
+	return -1, 0, -1
+}
+
+// Finds the longest of names that value starts with as a whole word, ignoring case.
+// Returns its byte length and its index in its list, or -1 if there is none.
+func matchName(value string, names [][]string) (length int, index int) {
+	index = -1
+	for _, list := range names {
+		for j, candidate := range list {
+			if candidate == "" || len(candidate) <= length || len(value) < len(candidate) || !strings.EqualFold(value[:len(candidate)], candidate) {
+				continue
+			}
+			if next, _ := utf8.DecodeRuneInString(value[len(candidate):]); unicode.IsLetter(next) {
+				continue
+			}
+			length, index = len(candidate), j
+		}
+	}
+	return length, index
+}
+
+// Returns the English name of a month or weekday index, as expected by the time package for a layout name element.
+func englishName(name layoutName, index int) string {
+	switch name {
+	case longMonthName:
+		return englishMonths[index]
+	case shortMonthName:
+		return englishShortMonths[index]
+	case longWeekdayName:
+		return englishWeekdays[index]
+	default:
+		return englishShortWeekdays[index]
+	}
+}
@@ -0,0 +1,128 @@
+package date
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestDate_FormatLocale(t *testing.T) {
+	date := New(2026, time.October, 18)
+	tests := []struct {
+		name   string
+		locale *Locale
+		layout string
+		want   string
+	}{
+		{name: "pl genitive", locale: Polish(), layout: Polish().LongLayout, want: "18 października 2026"},
+		{name: "pl nominative", locale: Polish(), layout: "January 2006", want: "październik 2026"},
+		{name: "pl full", locale: Polish(), layout: Polish().FullLayout, want: "niedziela, 18 października 2026"},
+		{name: "pl medium", locale: Polish(), layout: Polish().MediumLayout, want: "18 paź 2026"},
+		{name: "pl short", locale: Polish(), layout: Polish().ShortLayout, want: "18.10.2026"},
+		{name: "de long", locale: German(), layout: German().LongLayout, want: "18. Oktober 2026"},
+		{name: "de full", locale: German(), layout: German().FullLayout, want: "Sonntag, 18. Oktober 2026"},
+		{name: "de short weekday", locale: German(), layout: "Mon 02.01.", want: "So. 18.10."},
+		{name: "fr full", locale: French(), layout: French().FullLayout, want: "dimanche 18 octobre 2026"},
+		{name: "es long", locale: Spanish(), layout: Spanish().LongLayout, want: "18 de octubre de 2026"},
+		{name: "en-GB medium", locale: BritishEnglish(), layout: BritishEnglish().MediumLayout, want: "18 Oct 2026"},
+		{name: "en-US full", locale: AmericanEnglish(), layout: AmericanEnglish().FullLayout, want: "Sunday, October 18, 2026"},
+		{name: "en-US short", locale: AmericanEnglish(), layout: AmericanEnglish().ShortLayout, want: "10/18/26"},
+		{name: "Day of year is not day of month", locale: Polish(), layout: "January 002", want: "październik 291"},
+		{name: "Month literal", locale: Polish(), layout: "Month: January", want: "Month: październik"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := date.FormatLocale(tt.layout, tt.locale); got != tt.want {
+				t.Errorf("Date.FormatLocale() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseLocale(t *testing.T) {
+	tests := []struct {
+		name    string
+		locale  *Locale
+		layout  string
+		value   string
+		want    Date
+		wantErr bool
+	}{
+		{name: "pl genitive", locale: Polish(), layout: Polish().LongLayout, value: "18 października 2026", want: New(2026, time.October, 18)},
+		{name: "pl nominative", locale: Polish(), layout: Polish().LongLayout, value: "18 październik 2026", want: New(2026, time.October, 18)},
+		{name: "pl upper case", locale: Polish(), layout: Polish().FullLayout, value: "NIEDZIELA, 18 PAŹDZIERNIKA 2026", want: New(2026, time.October, 18)},
+		{name: "de short", locale: German(), layout: "2. Jan 2006", value: "3. März 2024", want: New(2024, time.March, 3)},
+		{name: "fr weekday and month sharing a prefix", locale: French(), layout: "Mon 2 Jan 2006", value: "mar. 5 mars 2024", want: New(2024, time.March, 5)},
+		{name: "es weekday and month with the same abbreviation", locale: Spanish(), layout: "Mon 2 Jan 2006", value: "mar 5 mar 2024", want: New(2024, time.March, 5)},
+		{name: "Unknown name", locale: German(), layout: German().LongLayout, value: "18. October 2026", wantErr: true},
+		{name: "Name inside a word", locale: Polish(), layout: Polish().LongLayout, value: "18 xmaja 2026", wantErr: true},
+		{name: "Invalid day", locale: Polish(), layout: Polish().LongLayout, value: "31 listopada 2026", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLocale(tt.layout, tt.value, tt.locale)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseLocale() = %v, %v, want %v, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseLocale_RoundTrip(t *testing.T) {
+	for _, tag := range LocaleTags() {
+		locale, err := LookupLocale(tag)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, layout := range []string{locale.ShortLayout, locale.MediumLayout, locale.LongLayout, locale.FullLayout, "January 2, 2006", "Mon Jan 2 2006"} {
+			for date := range NewRange(New(2026, time.January, 1), New(2026, time.December, 31)).Days() {
+				value := date.FormatLocale(layout, locale)
+				if got, err := ParseLocale(layout, value, locale); err != nil || got != date {
+					t.Fatalf("%s: ParseLocale(%q, %q) = %v, %v, want %v", tag, layout, value, got, err, date)
+				}
+			}
+		}
+	}
+}
+
+func TestLookupLocale(t *testing.T) {
+	tests := []struct {
+		tag     string
+		want    string
+		wantErr bool
+	}{
+		{tag: "pl", want: "pl"},
+		{tag: "pl_PL", want: "pl"},
+		{tag: "de-AT", want: "de"},
+		{tag: "en-gb", want: "en-GB"},
+		{tag: "en", want: "en-US"},
+		{tag: "it", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			got, err := LookupLocale(tt.tag)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LookupLocale() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.Tag != tt.want {
+				t.Errorf("LookupLocale().Tag = %q, want %q", got.Tag, tt.want)
+			}
+		})
+	}
+	if got, want := LocaleTags(), []string{"de", "en-GB", "en-US", "es", "fr", "pl"}; !slices.Equal(got, want) {
+		t.Errorf("LocaleTags() = %v, want %v", got, want)
+	}
+}
+
+func TestLocale_Copy(t *testing.T) {
+	locale := Polish()
+	locale.Months[0] = "January"
+	locale.LongLayout = "2006-01-02"
+	if got := Polish(); got.Months[0] != "styczeń" || got.LongLayout != "2 January 2006" {
+		t.Errorf("Polish() = %v, want the built-in locale unchanged", got)
+	}
+	looked, _ := LookupLocale("pl")
+	if looked.Months[0] != "styczeń" {
+		t.Errorf("LookupLocale() = %v, want the built-in locale unchanged", looked)
+	}
+}
@@ -0,0 +1,227 @@
+package date
+
+import (
+	"database/sql/driver"
+)
+
+// Represents a date that may be null, such as a nullable SQL DATE column or an optional JSON field.
+//
+// # Remarks
+//
+// NullDate is the Date counterpart of [database/sql.NullTime]: Date holds the date when Valid is true.
+// The zero value is null. A null date is read from and written as SQL NULL, JSON null, and empty text,
+// and IsZero reports true for it, so fields tagged omitzero are omitted.
+type NullDate struct {
+	Date  Date
+	Valid bool // Valid is true if Date is not NULL.
+}
+
+// Creates a new valid instance of the NullDate structure.
+//
+// # Parameters
+//
+//	date Date
+//
+// The date.
+//
+// # Returns
+//
+//	nullDate NullDate
+//
+// The non-null date.
+func NewNullDate(date Date) (nullDate NullDate) {
+	return NullDate{Date: date, Valid: true}
+}
+
+// Returns a pointer to the date, or nil if it is null.
+//
+// # Returns
+//
+//	date *Date
+//
+// A pointer to a copy of Date if Valid is true, nil otherwise.
+func (nullDate NullDate) Ptr() (date *Date) {
+	if !nullDate.Valid {
+		return nil
+	}
+	value := nullDate.Date
+	return &value
+}
+
+// Reports whether nullDate is null.
+//
+// # Returns
+//
+// True if Valid is false; false otherwise, including for the valid date January 1, year 1.
+func (nullDate NullDate) IsZero() bool {
+	return !nullDate.Valid
+}
+
+// Returns the date formatted in the YYYY-MM-DD format, or an empty string if it is null.
+func (nullDate NullDate) String() string {
+	if !nullDate.Valid {
+		return ""
+	}
+	return nullDate.Date.String()
+}
+
+// Implements the [encoding.TextAppender] interface.
+//
+// # Parameters
+//
+//	bytes []byte
+//
+// Array of bytes to add YYYY-MM-DD formatted date.
+//
+// # Returns
+//
+//	result []byte
+//
+// Array of bytes with added YYYY-MM-DD formatted date; bytes unchanged if the date is null.
+//
+//	err error
+//
+// nil value.
+func (nullDate NullDate) AppendText(bytes []byte) (result []byte, err error) {
+	if !nullDate.Valid {
+		return bytes, nil
+	}
+	return nullDate.Date.AppendText(bytes)
+}
+
+// Implements the [encoding.TextMarshaler] interface.
+//
+// # Returns
+//
+//	data []byte
+//
+// The date in the YYYY-MM-DD format, or empty text if the date is null.
+//
+//	err error
+//
+// nil value.
+func (nullDate NullDate) MarshalText() (data []byte, err error) {
+	return nullDate.AppendText(nil)
+}
+
+// Implements the [encoding.TextUnmarshaler] interface.
+//
+// # Parameters
+//
+//	data []byte
+//
+// Text data.
+//
+// # Returns
+//
+//	err error
+//
+// Error when unmarshal problems, nil otherwise.
+//
+// # Remarks
+//
+// Empty text is unmarshaled as null; otherwise the date must be a string in the [time.DateOnly] format.
+func (nullDate *NullDate) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*nullDate = NullDate{}
+		return nil
+	}
+	var date Date
+	if err := date.UnmarshalText(data); err != nil {
+		return err
+	}
+	*nullDate = NewNullDate(date)
+	return nil
+}
+
+// Implements the [encoding/json.Marshaler] interface.
+//
+// # Returns
+//
+//	data []byte
+//
+// The date as a quoted string in the YYYY-MM-DD format, or null if the date is null.
+//
+//	err error
+//
+// nil value.
+func (nullDate NullDate) MarshalJSON() (data []byte, err error) {
+	if !nullDate.Valid {
+		return []byte("null"), nil
+	}
+	return nullDate.Date.MarshalJSON()
+}
+
+// Implements the [encoding/json.Unmarshaler] interface.
+//
+// # Parameters
+//
+//	data []byte
+//
+// JSON data.
+//
+// # Returns
+//
+//	err error
+//
+// Error when unmarshal problems, nil otherwise.
+//
+// # Remarks
+//
+// JSON null is unmarshaled as null; otherwise the date must be a quoted string in the [time.DateOnly] format.
+func (nullDate *NullDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*nullDate = NullDate{}
+		return nil
+	}
+	var date Date
+	if err := date.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*nullDate = NewNullDate(date)
+	return nil
+}
+
+// Implements the [database/sql/driver.Valuer] interface.
+//
+// # Returns
+//
+//	value driver.Value
+//
+// The date as a string in the YYYY-MM-DD format, or nil if the date is null.
+//
+//	err error
+//
+// nil value.
+func (nullDate NullDate) Value() (value driver.Value, err error) {
+	if !nullDate.Valid {
+		return nil, nil
+	}
+	return nullDate.Date.Value()
+}
+
+// Implements the [database/sql.Scanner] interface.
+//
+// # Parameters
+//
+//	value any
+//
+// Value from database to scan; nil for SQL NULL.
+//
+// # Returns
+//
+//	err error
+//
+// Database scan error.
+func (nullDate *NullDate) Scan(value any) (err error) {
+	if value == nil {
+		*nullDate = NullDate{}
+		return nil
+	}
+	var date Date
+	if err := date.Scan(value); err != nil {
+		return err
+	}
+	*nullDate = NewNullDate(date)
+	return nil
+}
@@ -0,0 +1,94 @@
+package date
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNullDate_JSON(t *testing.T) {
+	type record struct {
+		Due     NullDate `json:"due"`
+		Omitted NullDate `json:"omitted,omitzero"`
+	}
+	tests := []struct {
+		name   string
+		record record
+		json   string
+	}{
+		{name: "Null", record: record{}, json: `{"due":null}`},
+		{name: "Valid", record: record{Due: NewNullDate(New(2024, time.May, 15))}, json: `{"due":"2024-05-15"}`},
+		{name: "Valid zero date", record: record{Due: NewNullDate(Date{}), Omitted: NewNullDate(Date{})}, json: `{"due":"0001-01-01","omitted":"0001-01-01"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.record)
+			if err != nil || string(data) != tt.json {
+				t.Fatalf("json.Marshal() = %s, %v, want %s", data, err, tt.json)
+			}
+			got := record{Due: NewNullDate(New(1999, time.January, 1))}
+			if err := json.Unmarshal(data, &got); err != nil || got != tt.record {
+				t.Errorf("json.Unmarshal() = %+v, %v, want %+v", got, err, tt.record)
+			}
+		})
+	}
+	var got NullDate
+	if err := json.Unmarshal([]byte(`20240515`), &got); err == nil {
+		t.Errorf("json.Unmarshal() error = nil, want error")
+	}
+}
+
+func TestNullDate_Text(t *testing.T) {
+	var got NullDate
+	if err := got.UnmarshalText([]byte("2024-05-15")); err != nil || got != NewNullDate(New(2024, time.May, 15)) {
+		t.Errorf("NullDate.UnmarshalText() = %v, %v", got, err)
+	}
+	if data, err := got.MarshalText(); err != nil || string(data) != "2024-05-15" {
+		t.Errorf("NullDate.MarshalText() = %s, %v", data, err)
+	}
+	if err := got.UnmarshalText(nil); err != nil || got.Valid {
+		t.Errorf("NullDate.UnmarshalText(nil) = %v, %v, want null", got, err)
+	}
+	if data, err := got.MarshalText(); err != nil || len(data) != 0 || got.String() != "" {
+		t.Errorf("NullDate.MarshalText() = %q, %v, want empty", data, err)
+	}
+	if got.Ptr() != nil || *NewNullDate(New(2024, time.May, 15)).Ptr() != New(2024, time.May, 15) {
+		t.Errorf("NullDate.Ptr() mismatch")
+	}
+}
+
+func TestNullDate_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    NullDate
+		wantErr bool
+	}{
+		{name: "NULL", value: nil, want: NullDate{}},
+		{name: "Time", value: time.Date(2024, time.May, 15, 0, 0, 0, 0, time.UTC), want: NewNullDate(New(2024, time.May, 15))},
+		{name: "String", value: "2024-05-15", want: NewNullDate(New(2024, time.May, 15))},
+		{name: "Bytes", value: []byte("2024-05-15"), want: NewNullDate(New(2024, time.May, 15))},
+		{name: "Invalid string", value: "15.05.2024", wantErr: true},
+		{name: "Unsupported type", value: 5, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewNullDate(New(1999, time.January, 1))
+			err := got.Scan(tt.value)
+			if (err != nil) != tt.wantErr || (!tt.wantErr && got != tt.want) {
+				t.Errorf("NullDate.Scan() = %v, %v, want %v, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+	var got NullDate
+	if err := got.Scan(5); err == nil || strings.Count(err.Error(), "Scan:") != 1 {
+		t.Errorf("NullDate.Scan() error = %v, want a single prefix", err)
+	}
+	if value, err := (NullDate{}).Value(); err != nil || value != nil {
+		t.Errorf("NullDate.Value() = %v, %v, want nil", value, err)
+	}
+	if value, err := NewNullDate(New(2024, time.May, 15)).Value(); err != nil || value != "2024-05-15" {
+		t.Errorf("NullDate.Value() = %v, %v", value, err)
+	}
+}
@@ -0,0 +1,271 @@
+package date
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Specifies how ParseAny reads numeric dates, such as 05/06/2024, that are valid both day-first and month-first.
+type DateOrder uint8
+
+const (
+	// Ambiguous dates are rejected with an AmbiguousDateError.
+	UnspecifiedOrder DateOrder = iota
+	// Ambiguous dates are read as day, month, year, so 05/06/2024 is June 5.
+	DayFirst
+	// Ambiguous dates are read as month, day, year, so 05/06/2024 is May 6.
+	MonthFirst
+)
+
+var dateOrderNames = [...]string{
+	UnspecifiedOrder: "unspecified",
+	DayFirst:         "day-first",
+	MonthFirst:       "month-first",
+}
+
+// Returns the name of the order, for example day-first.
+func (order DateOrder) String() string {
+	if int(order) < len(dateOrderNames) {
+		return dateOrderNames[order]
+	}
+	return fmt.Sprintf("DateOrder(%d)", uint8(order))
+}
+
+// Implements the [encoding.TextMarshaler] interface.
+//
+// # Returns
+//
+//	data []byte
+//
+// The name of the order.
+//
+//	err error
+//
+// An error if the order is not valid.
+func (order DateOrder) MarshalText() (data []byte, err error) {
+	if int(order) >= len(dateOrderNames) {
+		return nil, fmt.Errorf("date.DateOrder.MarshalText: invalid order %d", uint8(order))
+	}
+	return []byte(dateOrderNames[order]), nil
+}
+
+// Implements the [encoding.TextUnmarshaler] interface.
+//
+// # Parameters
+//
+//	data []byte
+//
+// Text data.
+//
+// # Returns
+//
+//	err error
+//
+// An error if data is not a known order.
+func (order *DateOrder) UnmarshalText(data []byte) error {
+	i := slices.Index(dateOrderNames[:], string(data))
+	if i < 0 {
+		return fmt.Errorf("date.DateOrder.UnmarshalText: unknown order %q", data)
+	}
+	*order = DateOrder(i)
+	return nil
+}
+
+// Represents the options of ParseAny.
+type ParseOptions struct {
+	// Order resolves numeric dates that are valid both day-first and month-first.
+	Order DateOrder
+	// Layouts are additional layouts, as for Parse, tried in order before the built-in formats.
+	Layouts []string
+}
+
+// Reports a date that ParseAny could read in more than one way.
+//
+// # Remarks
+//
+// Layouts lists the candidate layouts, as for Parse, so that a caller can pick one or set ParseOptions.Order.
+type AmbiguousDateError struct {
+	Value   string
+	Layouts []string
+}
+
+// Returns the error message, listing the candidate layouts.
+func (err *AmbiguousDateError) Error() string {
+	return fmt.Sprintf("date.ParseAny: ambiguous date %q matches layouts %q", err.Value, err.Layouts)
+}
+
+// Parses a date whose format is not known in advance.
+//
+// # Parameters
+//
+//	value string
+//
+// The date to parse. Leading and trailing white space is ignored.
+//
+//	options ParseOptions
+//
+// The parse options.
+//
+// # Returns
+//
+//	date Date
+//
+// The parsed date.
+//
+//	err error
+//
+// An *AmbiguousDateError if value is valid both day-first and month-first and options.Order is UnspecifiedOrder,
+// an error if value is not valid in options.Order, or another error if value is not a valid date in any recognized format;
+// the error for an unrecognized format lists the accepted formats.
+//
+// # Remarks
+//
+// The recognized formats are:
+//   - ISO 8601 extended and basic dates: 2024-05-15, 20240515;
+//   - ISO 8601 ordinal dates: 2024-136, 2024136;
+//   - ISO 8601 week dates: 2024-W20-3, 2024W203;
+//   - RFC 3339 timestamps, whose date part is taken as written, without conversion to UTC: 2024-05-15T23:30:00-05:00;
+//   - year-first numeric dates: 2024/05/15, 2024.5.15;
+//   - numeric dates with a day and a month of one or two digits separated by '.', '/' or '-': 15.05.2024, 05/15/2024;
+//   - dates with an English month name: 15 May 2024, 5 January 2024, 15-May-2024.
+//
+// With UnspecifiedOrder, a numeric date whose fields form a valid date in only one order is read in that order, so 05/15/2024 is May 15.
+// With DayFirst or MonthFirst, a numeric date is read only in that order, so 05/15/2024 is an error with DayFirst.
+func ParseAny(value string, options ParseOptions) (date Date, err error) {
+	s := strings.TrimSpace(value)
+	for _, layout := range options.Layouts {
+		if date, err := Parse(layout, s); err == nil {
+			return date, nil
+		}
+	}
+	switch {
+	case len(s) == 8 && isDigits(s):
+		return parseAnyLayout(value, "20060102", s)
+	case len(s) == 7 && isDigits(s):
+		return parseOrdinalDate(value, s[:4], s[4:])
+	case len(s) == 8 && s[4] == '-' && isDigits(s[:4]) && isDigits(s[5:]):
+		return parseOrdinalDate(value, s[:4], s[5:])
+	case len(s) > 5 && (s[4] == 'W' || s[4] == '-' && s[5] == 'W'):
+		date, err := ParseISOWeekDate(s)
+		if err != nil {
+			return Date{}, fmt.Errorf("date.ParseAny: invalid week date %q", value)
+		}
+		return date, nil
+	case len(s) == 10 && s[4] == '-' && s[7] == '-':
+		return parseAnyLayout(value, time.DateOnly, s)
+	case len(s) > 10 && s[4] == '-' && s[7] == '-' && strings.ContainsRune("Tt ", rune(s[10])):
+		t, err := time.Parse(time.RFC3339, s[:10]+"T"+s[11:])
+		if err != nil {
+			return Date{}, fmt.Errorf("date.ParseAny: invalid timestamp %q", value)
+		}
+		return DateOf(t), nil
+	}
+	if i := strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' }); i > 0 && strings.ContainsRune("./-", rune(s[i])) {
+		if parts := strings.Split(s, s[i:i+1]); len(parts) == 3 && isDigits(parts[0]) && isDigits(parts[1]) && isDigits(parts[2]) {
+			return parseNumericDate(value, s[i:i+1], parts, options)
+		}
+	}
+	for _, layout := range monthNameLayouts {
+		if date, err := Parse(layout, s); err == nil {
+			return date, nil
+		}
+	}
+	return Date{}, unrecognizedDateError(value, options)
+}
+
+// The layouts with an English month name recognized by ParseAny.
+var monthNameLayouts = [...]string{"2 Jan 2006", "2 January 2006", "2-Jan-2006", "2-January-2006"}
+
+// Returns the error for a value in no format recognized by ParseAny, listing the accepted formats as layouts of Parse,
+// except for the week dates, which have no such layout.
+func unrecognizedDateError(value string, options ParseOptions) error {
+	layouts := slices.Concat(options.Layouts, []string{
+		time.DateOnly, "20060102", "2006-002", "2006002", "2006-W01-1", "2006W011", time.RFC3339, "2006/1/2", "2006.1.2", "2006-1-2",
+	})
+	if options.Order != MonthFirst {
+		layouts = append(layouts, "2.1.2006", "2/1/2006", "2-1-2006")
+	}
+	if options.Order != DayFirst {
+		layouts = append(layouts, "1.2.2006", "1/2/2006", "1-2-2006")
+	}
+	layouts = append(layouts, monthNameLayouts[:]...)
+	return fmt.Errorf("date.ParseAny: unrecognized date %q, accepted formats are %q", value, layouts)
+}
+
+// Reports whether value is a non-empty string of ASCII digits.
+func isDigits(value string) bool {
+	_, err := parseDigits(value)
+	return err == nil
+}
+
+// Parses s with layout, reporting value in the error.
+func parseAnyLayout(value string, layout string, s string) (date Date, err error) {
+	date, err = Parse(layout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("date.ParseAny: invalid date %q: %w", value, err)
+	}
+	return date, nil
+}
+
+// Parses an ISO 8601 ordinal date of the four-digit year and the three-digit day of the year.
+func parseOrdinalDate(value string, year string, day string) (date Date, err error) {
+	y, _ := parseDigits(year)
+	d, err := parseDigits(day)
+	first := New(y, time.January, 1)
+	if err != nil || len(day) != 3 || d < 1 || d > first.DaysInYear() {
+		return Date{}, fmt.Errorf("date.ParseAny: invalid ordinal date %q", value)
+	}
+	return first.AddDays(d - 1), nil
+}
+
+// Parses a numeric date of three parts separated by separator.
+func parseNumericDate(value string, separator string, parts []string, options ParseOptions) (date Date, err error) {
+	a, _ := parseDigits(parts[0])
+	b, _ := parseDigits(parts[1])
+	c, _ := parseDigits(parts[2])
+	if len(parts[0]) == 4 && len(parts[1]) <= 2 && len(parts[2]) <= 2 {
+		if date, ok := validDate(a, b, c); ok {
+			return date, nil
+		}
+		return Date{}, fmt.Errorf("date.ParseAny: invalid date %q", value)
+	}
+	if len(parts[0]) > 2 || len(parts[1]) > 2 || len(parts[2]) != 4 {
+		return Date{}, unrecognizedDateError(value, options)
+	}
+	dayFirst, dayFirstOK := validDate(c, b, a)
+	monthFirst, monthFirstOK := validDate(c, a, b)
+	switch {
+	case options.Order == DayFirst:
+		if !dayFirstOK {
+			return Date{}, fmt.Errorf("date.ParseAny: invalid day-first date %q", value)
+		}
+		return dayFirst, nil
+	case options.Order == MonthFirst:
+		if !monthFirstOK {
+			return Date{}, fmt.Errorf("date.ParseAny: invalid month-first date %q", value)
+		}
+		return monthFirst, nil
+	case dayFirstOK && monthFirstOK && dayFirst != monthFirst:
+		return Date{}, &AmbiguousDateError{
+			Value:   value,
+			Layouts: []string{"2" + separator + "1" + separator + "2006", "1" + separator + "2" + separator + "2006"},
+		}
+	case dayFirstOK:
+		return dayFirst, nil
+	case monthFirstOK:
+		return monthFirst, nil
+	default:
+		return Date{}, fmt.Errorf("date.ParseAny: invalid date %q", value)
+	}
+}
+
+// Returns the date of year, month, and day, and whether they form a valid date.
+func validDate(year int, month int, day int) (date Date, ok bool) {
+	if month < 1 || month > 12 || day < 1 {
+		return Date{}, false
+	}
+	date = New(year, time.Month(month), day)
+	return date, date.Day() == day
+}
@@ -0,0 +1,114 @@
+package date
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseAny(t *testing.T) {
+	may15 := New(2024, time.May, 15)
+	tests := []struct {
+		value   string
+		options ParseOptions
+		want    Date
+		wantErr bool
+	}{
+		{value: "2024-05-15", want: may15},
+		{value: "20240515", want: may15},
+		{value: "2024-136", want: may15},
+		{value: "2024136", want: may15},
+		{value: "2023-365", want: New(2023, time.December, 31)},
+		{value: "2023-366", wantErr: true},
+		{value: "2024-W20-3", want: may15},
+		{value: "2024W203", want: may15},
+		{value: "2024-W20-8", wantErr: true},
+		{value: "2024-05-15T23:30:00-05:00", want: may15},
+		{value: "2024-05-15T23:30:00.123Z", want: may15},
+		{value: "2024-05-15 01:00:00+02:00", want: may15},
+		{value: "2024-05-15T25:00:00Z", wantErr: true},
+		{value: "2024/05/15", want: may15},
+		{value: "2024-5-15", want: may15},
+		{value: "15.05.2024", want: may15},
+		{value: "15.5.2024", want: may15},
+		{value: "05/15/2024", want: may15},
+		{value: "05/15/2024", options: ParseOptions{Order: DayFirst}, wantErr: true},
+		{value: "15/05/2024", options: ParseOptions{Order: MonthFirst}, wantErr: true},
+		{value: "15/05/2024", options: ParseOptions{Order: DayFirst}, want: may15},
+		{value: "05/15/2024", options: ParseOptions{Order: MonthFirst}, want: may15},
+		{value: "05/05/2024", options: ParseOptions{Order: MonthFirst}, want: New(2024, time.May, 5)},
+		{value: "15-05-2024", want: may15},
+		{value: "05/05/2024", want: New(2024, time.May, 5)},
+		{value: "05/06/2024", options: ParseOptions{Order: DayFirst}, want: New(2024, time.June, 5)},
+		{value: "05/06/2024", options: ParseOptions{Order: MonthFirst}, want: New(2024, time.May, 6)},
+		{value: "05/06/2024", wantErr: true},
+		{value: "31/02/2024", wantErr: true},
+		{value: "15 May 2024", want: may15},
+		{value: " 5 January 2024 ", want: New(2024, time.January, 5)},
+		{value: "15-may-2024", want: may15},
+		{value: "15/May/2024", options: ParseOptions{Layouts: []string{"02/Jan/2006"}}, want: may15},
+		{value: "May 15th, 2024", wantErr: true},
+		{value: "2024.05.15.", wantErr: true},
+		{value: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseAny(tt.value, tt.options)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseAny() = %v, %v, want %v, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseAny_AmbiguousDateError(t *testing.T) {
+	_, err := ParseAny("05.06.2024", ParseOptions{})
+	var ambiguous *AmbiguousDateError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("ParseAny() error = %v, want *AmbiguousDateError", err)
+	}
+	if want := []string{"2.1.2006", "1.2.2006"}; !slices.Equal(ambiguous.Layouts, want) {
+		t.Errorf("AmbiguousDateError.Layouts = %q, want %q", ambiguous.Layouts, want)
+	}
+	for _, layout := range ambiguous.Layouts {
+		if _, err := Parse(layout, "05.06.2024"); err != nil {
+			t.Errorf("Parse(%q) error = %v", layout, err)
+		}
+	}
+	if _, err := ParseAny("2024-13-01", ParseOptions{}); errors.As(err, &ambiguous) || err == nil {
+		t.Errorf("ParseAny() error = %v, want invalid date error", err)
+	}
+}
+
+func TestParseAny_UnrecognizedDate(t *testing.T) {
+	_, err := ParseAny("May 15th, 2024", ParseOptions{Order: DayFirst, Layouts: []string{"Jan 2 2006"}})
+	if err == nil {
+		t.Fatal("ParseAny() error = nil, want an error")
+	}
+	for _, layout := range []string{`"Jan 2 2006"`, `"2006-01-02"`, `"2.1.2006"`, `"2 January 2006"`} {
+		if !strings.Contains(err.Error(), layout) {
+			t.Errorf("ParseAny() error = %v, want %s listed", err, layout)
+		}
+	}
+	if strings.Contains(err.Error(), `"1/2/2006"`) {
+		t.Errorf("ParseAny() error = %v, want no month-first layouts with DayFirst", err)
+	}
+}
+
+func TestDateOrder_Text(t *testing.T) {
+	var order DateOrder
+	if err := order.UnmarshalText([]byte("month-first")); err != nil || order != MonthFirst {
+		t.Errorf("DateOrder.UnmarshalText() = %v, %v", order, err)
+	}
+	if data, err := DayFirst.MarshalText(); err != nil || string(data) != "day-first" {
+		t.Errorf("DateOrder.MarshalText() = %s, %v", data, err)
+	}
+	if err := order.UnmarshalText([]byte("year-first")); err == nil {
+		t.Errorf("DateOrder.UnmarshalText() error = nil, want error")
+	}
+	if _, err := DateOrder(9).MarshalText(); err == nil {
+		t.Errorf("DateOrder.MarshalText() error = nil, want error")
+	}
+}
@@ -0,0 +1,605 @@
+package date
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Represents a compiled date pattern in the Unicode LDML syntax, as used by ICU and Java, such as EEE d MMM y,
+// or in the .NET custom format syntax, such as ddd d MMM yyyy.
+//
+// # Remarks
+//
+// A Pattern is immutable, so it can be compiled once, for example into a package variable, and used concurrently.
+// Names are English unless a locale is given, as in FormatLocale and ParseLocale.
+type Pattern struct {
+	source   string
+	elements []patternElement
+}
+
+// The field of a pattern element.
+type patternKind uint8
+
+const (
+	literalKind patternKind = iota
+	yearKind
+	weekYearKind
+	quarterKind
+	monthKind
+	dayKind
+	dayOfYearKind
+	weekKind
+	weekdayKind
+)
+
+type patternElement struct {
+	kind patternKind
+	// The number of pattern letters: 1 or 2 for numbers, 3 for abbreviated names and 4 for full names.
+	width int
+	// For a year or a week-year, whether only its last two digits are written.
+	twoDigit bool
+	// For a full month name, whether the genitive case is used.
+	genitive bool
+	literal  string
+}
+
+// Reports whether the element is written as a number.
+func (element patternElement) numeric() bool {
+	switch element.kind {
+	case literalKind, weekdayKind:
+		return false
+	case quarterKind, monthKind:
+		return element.width < 3
+	default:
+		return true
+	}
+}
+
+// Returns the layout name element of a month or weekday name element.
+func (element patternElement) layoutName() layoutName {
+	switch {
+	case element.kind == monthKind && element.width == 3:
+		return shortMonthName
+	case element.kind == monthKind:
+		return longMonthName
+	case element.width == 3:
+		return shortWeekdayName
+	default:
+		return longWeekdayName
+	}
+}
+
+// Returns the number of digits read by the element when it is next to another number, as in yyyyMMdd.
+func (element patternElement) fixedWidth() int {
+	switch {
+	case element.twoDigit:
+		return 2
+	case element.kind == yearKind || element.kind == weekYearKind:
+		return max(element.width, 4)
+	case element.kind == quarterKind:
+		return 1
+	case element.kind == dayOfYearKind:
+		return 3
+	default:
+		return 2
+	}
+}
+
+// Compiles a Unicode LDML date pattern, as used by ICU and by java.time.format.DateTimeFormatter.
+//
+// # Parameters
+//
+//	pattern string
+//
+// The pattern, for example dd.MM.yyyy or EEE d MMM y.
+//
+// # Returns
+//
+//	result *Pattern
+//
+// The compiled pattern.
+//
+//	err error
+//
+// An error if pattern contains an unsupported field or an unterminated quote.
+//
+// # Remarks
+//
+// The supported fields are:
+//   - y, yyy, yyyy, and so on: the year, padded to the number of letters; yy: the last two digits of the year;
+//   - Y: the ISO 8601 week-numbering year, with the same widths as y;
+//   - u: the same as y;
+//   - Q, QQ: the quarter as a number, 1 or 01; QQQ: the quarter as Q1; q is the same as Q;
+//   - M, MM: the month as a number, 5 or 05; MMM: the abbreviated name; MMMM: the full name in the genitive case,
+//     as in 18 października; L to LLLL: the same, but LLLL is the full name in the nominative case, as in październik;
+//   - d, dd: the day of the month; D, DD, DDD: the day of the year;
+//   - w, ww: the ISO 8601 week of the year;
+//   - E, EE, EEE: the abbreviated weekday name; EEEE: the full weekday name.
+//
+// Text between apostrophes is literal and two apostrophes stand for one. Other characters that are not ASCII letters are literal.
+func CompileLDML(pattern string) (result *Pattern, err error) {
+	var elements []patternElement
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		switch {
+		case c == '\'':
+			literal, n, ok := quotedLiteral(pattern[i:], c, false)
+			if !ok {
+				return nil, fmt.Errorf("date.CompileLDML: unterminated quote in %q", pattern)
+			}
+			elements = appendLiteral(elements, literal)
+			i += n
+		case 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z':
+			n := repeated(pattern[i:])
+			element, ok := ldmlElement(c, n)
+			if !ok {
+				return nil, fmt.Errorf("date.CompileLDML: unsupported field %q in %q", pattern[i:i+n], pattern)
+			}
+			elements = append(elements, element)
+			i += n
+		default:
+			_, n := utf8.DecodeRuneInString(pattern[i:])
+			elements = appendLiteral(elements, pattern[i:i+n])
+			i += n
+		}
+	}
+	return &Pattern{source: pattern, elements: elements}, nil
+}
+
+// Returns the element of n repeated LDML pattern letters c.
+func ldmlElement(c byte, n int) (element patternElement, ok bool) {
+	switch {
+	case c == 'y' || c == 'u':
+		return patternElement{kind: yearKind, width: n, twoDigit: n == 2}, true
+	case c == 'Y':
+		return patternElement{kind: weekYearKind, width: n, twoDigit: n == 2}, true
+	case (c == 'Q' || c == 'q') && n <= 3:
+		return patternElement{kind: quarterKind, width: n}, true
+	case (c == 'M' || c == 'L') && n <= 4:
+		return patternElement{kind: monthKind, width: n, genitive: c == 'M'}, true
+	case c == 'd' && n <= 2:
+		return patternElement{kind: dayKind, width: n}, true
+	case c == 'D' && n <= 3:
+		return patternElement{kind: dayOfYearKind, width: n}, true
+	case c == 'w' && n <= 2:
+		return patternElement{kind: weekKind, width: n}, true
+	case c == 'E' && n <= 4:
+		return patternElement{kind: weekdayKind, width: max(n, 3)}, true
+	default:
+		return patternElement{}, false
+	}
+}
+
+// Compiles a .NET custom date and time format string, as used by DateTime.ToString and DateTime.ParseExact.
+//
+// # Parameters
+//
+//	pattern string
+//
+// The format string, for example dd.MM.yyyy or ddd, d MMMM yyyy.
+//
+// # Returns
+//
+//	result *Pattern
+//
+// The compiled pattern.
+//
+//	err error
+//
+// An error if pattern contains a time, time zone, or era specifier, an unterminated quote, or a trailing backslash.
+//
+// # Remarks
+//
+// The supported specifiers are:
+//   - y: the last two digits of the year without padding; yy: the last two digits of the year; yyy, yyyy, and so on: the year, padded to the number of letters;
+//   - M, MM: the month as a number; MMM: the abbreviated name; MMMM: the full name, in the genitive case if the pattern contains d or dd;
+//   - d, dd: the day of the month; ddd: the abbreviated weekday name; dddd: the full weekday name.
+//
+// Text between apostrophes or quotation marks is literal, a backslash makes the next character literal,
+// and a percent sign before a single specifier is ignored. Other characters, including the / and : separators, are literal.
+func CompileDotNet(pattern string) (result *Pattern, err error) {
+	var elements []patternElement
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		switch c {
+		case '\'', '"':
+			literal, n, ok := quotedLiteral(pattern[i:], c, true)
+			if !ok {
+				return nil, fmt.Errorf("date.CompileDotNet: unterminated quote in %q", pattern)
+			}
+			elements = appendLiteral(elements, literal)
+			i += n
+		case '\\':
+			if i+1 == len(pattern) {
+				return nil, fmt.Errorf("date.CompileDotNet: trailing backslash in %q", pattern)
+			}
+			_, n := utf8.DecodeRuneInString(pattern[i+1:])
+			elements = appendLiteral(elements, pattern[i+1:i+1+n])
+			i += 1 + n
+		case '%':
+			i++
+		case 'y':
+			n := repeated(pattern[i:])
+			elements = append(elements, patternElement{kind: yearKind, width: n, twoDigit: n <= 2})
+			i += n
+		case 'M':
+			n := repeated(pattern[i:])
+			elements = append(elements, patternElement{kind: monthKind, width: min(n, 4)})
+			i += n
+		case 'd':
+			n := repeated(pattern[i:])
+			if n <= 2 {
+				elements = append(elements, patternElement{kind: dayKind, width: n})
+			} else {
+				elements = append(elements, patternElement{kind: weekdayKind, width: min(n, 4)})
+			}
+			i += n
+		case 'g', 'h', 'H', 'm', 's', 'f', 'F', 't', 'K', 'z':
+			n := repeated(pattern[i:])
+			return nil, fmt.Errorf("date.CompileDotNet: unsupported specifier %q in %q", pattern[i:i+n], pattern)
+		default:
+			_, n := utf8.DecodeRuneInString(pattern[i:])
+			elements = appendLiteral(elements, pattern[i:i+n])
+			i += n
+		}
+	}
+	genitive := false
+	for _, element := range elements {
+		genitive = genitive || element.kind == dayKind
+	}
+	for i := range elements {
+		elements[i].genitive = elements[i].kind == monthKind && genitive
+	}
+	return &Pattern{source: pattern, elements: elements}, nil
+}
+
+// Returns the number of leading repetitions of the first byte of value.
+func repeated(value string) int {
+	n := 1
+	for n < len(value) && value[n] == value[0] {
+		n++
+	}
+	return n
+}
+
+// Reads the literal text quoted by quote at the start of value.
+// Returns the text and the number of bytes read, including the quotes.
+// A doubled quote stands for one quote; with backslash set, a backslash makes the next character literal instead.
+func quotedLiteral(value string, quote byte, backslash bool) (literal string, n int, ok bool) {
+	if !backslash && len(value) > 1 && value[1] == quote {
+		return string(quote), 2, true
+	}
+	var bytes []byte
+	for i := 1; i < len(value); i++ {
+		switch {
+		case backslash && value[i] == '\\' && i+1 < len(value):
+			i++
+			bytes = append(bytes, value[i])
+		case value[i] != quote:
+			bytes = append(bytes, value[i])
+		case !backslash && i+1 < len(value) && value[i+1] == quote:
+			i++
+			bytes = append(bytes, quote)
+		default:
+			return string(bytes), i + 1, true
+		}
+	}
+	return "", 0, false
+}
+
+// Appends literal to elements, merging it with a preceding literal.
+func appendLiteral(elements []patternElement, literal string) []patternElement {
+	if n := len(elements); n > 0 && elements[n-1].kind == literalKind {
+		elements[n-1].literal += literal
+		return elements
+	}
+	return append(elements, patternElement{kind: literalKind, literal: literal})
+}
+
+// Returns the source of the pattern.
+func (pattern *Pattern) String() string {
+	return pattern.source
+}
+
+// Returns the date formatted according to the pattern, with English month and weekday names.
+//
+// # Parameters
+//
+//	date Date
+//
+// The date to format.
+//
+// # Returns
+//
+//	result string
+//
+// The formatted date.
+func (pattern *Pattern) Format(date Date) (result string) {
+	return string(pattern.appendFormat(nil, date, &americanEnglish))
+}
+
+// Returns the date formatted according to the pattern, with month and weekday names in the language of locale.
+//
+// # Parameters
+//
+//	date Date
+//
+// The date to format.
+//
+//	locale *Locale
+//
+// The locale.
+//
+// # Returns
+//
+//	result string
+//
+// The formatted date.
+func (pattern *Pattern) FormatLocale(date Date, locale *Locale) (result string) {
+	return string(pattern.appendFormat(nil, date, locale))
+}
+
+// Appends the date formatted according to the pattern, with English month and weekday names, to bytes.
+//
+// # Parameters
+//
+//	bytes []byte
+//
+// Array of bytes to add the formatted date.
+//
+//	date Date
+//
+// The date to format.
+//
+// # Returns
+//
+//	result []byte
+//
+// Array of bytes with added formatted date.
+func (pattern *Pattern) AppendFormat(bytes []byte, date Date) (result []byte) {
+	return pattern.appendFormat(bytes, date, &americanEnglish)
+}
+
+func (pattern *Pattern) appendFormat(bytes []byte, date Date, locale *Locale) []byte {
+	year, month, day := date.Deconstruct()
+	for _, element := range pattern.elements {
+		switch element.kind {
+		case literalKind:
+			bytes = append(bytes, element.literal...)
+		case yearKind, weekYearKind:
+			value := year
+			if element.kind == weekYearKind {
+				value, _ = date.ISOWeek()
+			}
+			if element.twoDigit {
+				bytes = appendPadded(bytes, value%100, element.width)
+			} else {
+				bytes = appendPadded(bytes, value, element.width)
+			}
+		case quarterKind:
+			if element.width == 3 {
+				bytes = append(bytes, 'Q', byte('0'+date.Quarter()))
+			} else {
+				bytes = appendPadded(bytes, date.Quarter(), element.width)
+			}
+		case monthKind:
+			switch {
+			case element.width < 3:
+				bytes = appendPadded(bytes, int(month), element.width)
+			case element.width == 3:
+				bytes = append(bytes, locale.ShortMonths[month-1]...)
+			case element.genitive && locale.MonthsGenitive[month-1] != "":
+				bytes = append(bytes, locale.MonthsGenitive[month-1]...)
+			default:
+				bytes = append(bytes, locale.Months[month-1]...)
+			}
+		case dayKind:
+			bytes = appendPadded(bytes, day, element.width)
+		case dayOfYearKind:
+			bytes = appendPadded(bytes, date.YearDay(), element.width)
+		case weekKind:
+			_, week := date.ISOWeek()
+			bytes = appendPadded(bytes, week, element.width)
+		case weekdayKind:
+			if element.width == 3 {
+				bytes = append(bytes, locale.ShortWeekdays[date.Weekday()]...)
+			} else {
+				bytes = append(bytes, locale.Weekdays[date.Weekday()]...)
+			}
+		}
+	}
+	return bytes
+}
+
+// Appends value to bytes, padded with zeros to width digits.
+func appendPadded(bytes []byte, value int, width int) []byte {
+	if value < 0 {
+		bytes = append(bytes, '-')
+		value = -value
+	}
+	for digits := len(strconv.Itoa(value)); digits < width; digits++ {
+		bytes = append(bytes, '0')
+	}
+	return strconv.AppendInt(bytes, int64(value), 10)
+}
+
+// Parses a date formatted according to the pattern, with English month and weekday names.
+//
+// # Parameters
+//
+//	value string
+//
+// The date to parse.
+//
+// # Returns
+//
+//	date Date
+//
+// The parsed date.
+//
+//	err error
+//
+// An error if value does not match the pattern or its fields are not consistent.
+//
+// # Remarks
+//
+// See ParseLocale.
+func (pattern *Pattern) Parse(value string) (date Date, err error) {
+	return pattern.ParseLocale(value, &americanEnglish)
+}
+
+// Parses a date formatted according to the pattern, with month and weekday names in the language of locale.
+//
+// # Parameters
+//
+//	value string
+//
+// The date to parse.
+//
+//	locale *Locale
+//
+// The locale.
+//
+// # Returns
+//
+//	date Date
+//
+// The parsed date.
+//
+//	err error
+//
+// An error if value does not match the pattern or its fields are not consistent.
+//
+// # Remarks
+//
+// Names are matched case-insensitively; a full month name may be in the nominative or the genitive case.
+// A number next to another number, as in yyyyMMdd, must have its full width; otherwise it may have fewer digits.
+// A two-digit year yy is in the range 1969 to 2068, as with the time package.
+//
+// The date is taken from the ISO 8601 week-numbering year, week, and weekday if the pattern has a week or a week-year,
+// otherwise from the year and the day of the year if it has a day of the year, otherwise from the year, month, and day.
+// A missing year is 1, a missing month is the first month of the quarter or January, and a missing day, week, or weekday is the first one.
+// The remaining fields, such as the weekday of d MMM y EEE, must agree with that date.
+func (pattern *Pattern) ParseLocale(value string, locale *Locale) (date Date, err error) {
+	var values [weekdayKind + 1]int
+	var set [weekdayKind + 1]bool
+	rest := value
+	for i, element := range pattern.elements {
+		n, v := 0, 0
+		switch {
+		case element.kind == literalKind:
+			if !strings.HasPrefix(rest, element.literal) {
+				return Date{}, pattern.parseError(value, fmt.Sprintf("expected %q at %q", element.literal, rest))
+			}
+			rest = rest[len(element.literal):]
+			continue
+		case element.numeric():
+			for n < len(rest) && '0' <= rest[n] && rest[n] <= '9' {
+				n++
+			}
+			if i > 0 && pattern.elements[i-1].numeric() || i+1 < len(pattern.elements) && pattern.elements[i+1].numeric() {
+				if n < element.fixedWidth() {
+					return Date{}, pattern.parseError(value, fmt.Sprintf("expected %d digits at %q", element.fixedWidth(), rest))
+				}
+				n = element.fixedWidth()
+			}
+			if n == 0 || n > 9 || element.twoDigit && n > 2 {
+				return Date{}, pattern.parseError(value, fmt.Sprintf("invalid number at %q", rest))
+			}
+			v, _ = strconv.Atoi(rest[:n])
+			if element.twoDigit {
+				v += 1900
+				if v < 1969 {
+					v += 100
+				}
+			}
+		case element.kind == quarterKind:
+			if len(rest) < 2 || rest[0] != 'Q' && rest[0] != 'q' || rest[1] < '0' || rest[1] > '9' {
+				return Date{}, pattern.parseError(value, fmt.Sprintf("invalid quarter at %q", rest))
+			}
+			n, v = 2, int(rest[1]-'0')
+		default:
+			if n, v = matchName(rest, locale.names(element.layoutName())); v < 0 {
+				return Date{}, pattern.parseError(value, fmt.Sprintf("unknown name at %q", rest))
+			}
+			if element.kind == monthKind {
+				v++
+			}
+		}
+		if set[element.kind] && values[element.kind] != v {
+			return Date{}, pattern.parseError(value, fmt.Sprintf("conflicting values of the same field at %q", rest))
+		}
+		values[element.kind], set[element.kind] = v, true
+		rest = rest[n:]
+	}
+	if rest != "" {
+		return Date{}, pattern.parseError(value, fmt.Sprintf("extra text %q", rest))
+	}
+	return pattern.resolve(value, values, set)
+}
+
+// Returns the date of the parsed fields of value.
+func (pattern *Pattern) resolve(value string, values [weekdayKind + 1]int, set [weekdayKind + 1]bool) (date Date, err error) {
+	year := 1
+	if set[yearKind] {
+		year = values[yearKind]
+	}
+	if set[quarterKind] && (values[quarterKind] < 1 || values[quarterKind] > 4) {
+		return Date{}, pattern.parseError(value, "quarter out of range")
+	}
+	if set[monthKind] && (values[monthKind] < 1 || values[monthKind] > 12) {
+		return Date{}, pattern.parseError(value, "month out of range")
+	}
+	switch {
+	case set[weekKind] || set[weekYearKind]:
+		weekYear, week, weekday := year, 1, time.Monday
+		if set[weekYearKind] {
+			weekYear = values[weekYearKind]
+		}
+		if set[weekKind] {
+			week = values[weekKind]
+		}
+		if set[weekdayKind] {
+			weekday = time.Weekday(values[weekdayKind])
+		}
+		if week < 1 || week > weeksInISOYear(weekYear) {
+			return Date{}, pattern.parseError(value, "week out of range")
+		}
+		date = FromISOWeek(weekYear, week, weekday)
+	case set[dayOfYearKind]:
+		date = New(year, time.January, 1)
+		if values[dayOfYearKind] < 1 || values[dayOfYearKind] > date.DaysInYear() {
+			return Date{}, pattern.parseError(value, "day of year out of range")
+		}
+		date = date.AddDays(values[dayOfYearKind] - 1)
+	default:
+		month, day := 1, 1
+		if set[monthKind] {
+			month = values[monthKind]
+		} else if set[quarterKind] {
+			month = 3*values[quarterKind] - 2
+		}
+		if set[dayKind] {
+			day = values[dayKind]
+		}
+		var ok bool
+		if date, ok = validDate(year, month, day); !ok {
+			return Date{}, pattern.parseError(value, "day out of range")
+		}
+	}
+	switch {
+	case set[monthKind] && int(date.Month()) != values[monthKind],
+		set[dayKind] && date.Day() != values[dayKind],
+		set[quarterKind] && date.Quarter() != values[quarterKind],
+		set[weekdayKind] && int(date.Weekday()) != values[weekdayKind]:
+		return Date{}, pattern.parseError(value, fmt.Sprintf("fields do not agree with %v", date))
+	}
+	return date, nil
+}
+
+func (pattern *Pattern) parseError(value string, reason string) error {
+	return fmt.Errorf("date.Pattern.Parse: cannot parse %q as %q: %s", value, pattern.source, reason)
+}
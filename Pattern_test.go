@@ -0,0 +1,185 @@
+package date
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCompileLDML(t *testing.T) {
+	date := New(2026, time.October, 18)
+	tests := []struct {
+		pattern string
+		locale  *Locale
+		want    string
+		partial bool
+		wantErr bool
+	}{
+		{pattern: "dd.MM.yyyy", want: "18.10.2026"},
+		{pattern: "EEE d MMM y", want: "Sun 18 Oct 2026"},
+		{pattern: "EEEE, MMMM d, y", want: "Sunday, October 18, 2026"},
+		{pattern: "d/M/yy", want: "18/10/26"},
+		{pattern: "yyyyMMdd", want: "20261018"},
+		{pattern: "y-DDD", want: "2026-291"},
+		{pattern: "Y-'W'ww-E", want: "2026-W42-Sun"},
+		{pattern: "QQQ y", want: "Q4 2026", partial: true},
+		{pattern: "QQ/y", want: "04/2026", partial: true},
+		{pattern: "d MMMM y", locale: Polish(), want: "18 października 2026"},
+		{pattern: "LLLL y", locale: Polish(), want: "październik 2026", partial: true},
+		{pattern: "EEEE, d. MMMM y", locale: German(), want: "Sonntag, 18. Oktober 2026"},
+		{pattern: "h 'o''clock'", wantErr: true},
+		{pattern: "'o''clock' d", want: "o'clock 18", partial: true},
+		{pattern: "d ''", want: "18 '", partial: true},
+		{pattern: "d 'unterminated", wantErr: true},
+		{pattern: "MMMMM", wantErr: true},
+		{pattern: "G y", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			pattern, err := CompileLDML(tt.pattern)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CompileLDML() error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			locale := tt.locale
+			if locale == nil {
+				locale = AmericanEnglish()
+			}
+			if got := pattern.FormatLocale(date, locale); got != tt.want {
+				t.Errorf("Pattern.FormatLocale() = %q, want %q", got, tt.want)
+			}
+			if got, err := pattern.ParseLocale(tt.want, locale); err != nil || got != date && !tt.partial {
+				t.Errorf("Pattern.ParseLocale(%q) = %v, %v, want %v", tt.want, got, err, date)
+			}
+		})
+	}
+}
+
+func TestPattern_Format_WeekYear(t *testing.T) {
+	pattern, err := CompileLDML("YYYY-'W'ww yyyy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := pattern.Format(New(2024, time.December, 30)); got != "2025-W01 2024" {
+		t.Errorf("Pattern.Format() = %q, want %q", got, "2025-W01 2024")
+	}
+	if got, err := pattern.Parse("2025-W01 2024"); err != nil || got != New(2024, time.December, 30) {
+		t.Errorf("Pattern.Parse() = %v, %v, want 2024-12-30", got, err)
+	}
+}
+
+func TestCompileDotNet(t *testing.T) {
+	date := New(2026, time.March, 5)
+	tests := []struct {
+		pattern string
+		locale  *Locale
+		want    string
+		partial bool
+		wantErr bool
+	}{
+		{pattern: "dd.MM.yyyy", want: "05.03.2026"},
+		{pattern: "ddd d MMM yyyy", want: "Thu 5 Mar 2026"},
+		{pattern: "dddd, MMMM d, yyyy", want: "Thursday, March 5, 2026"},
+		{pattern: "M/d/yy", want: "3/5/26"},
+		{pattern: "%d", want: "5", partial: true},
+		{pattern: `yyyy'-W'MM\d`, want: "2026-W03d", partial: true},
+		{pattern: `"Day" d`, want: "Day 5", partial: true},
+		{pattern: "MMMM yyyy", locale: Polish(), want: "marzec 2026", partial: true},
+		{pattern: "d MMMM yyyy", locale: Polish(), want: "5 marca 2026"},
+		{pattern: "yyyy-MM-dd HH:mm", wantErr: true},
+		{pattern: "dd 'unterminated", wantErr: true},
+		{pattern: `dd\`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			pattern, err := CompileDotNet(tt.pattern)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CompileDotNet() error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			locale := tt.locale
+			if locale == nil {
+				locale = AmericanEnglish()
+			}
+			if got := pattern.FormatLocale(date, locale); got != tt.want {
+				t.Errorf("Pattern.FormatLocale() = %q, want %q", got, tt.want)
+			}
+			if got, err := pattern.ParseLocale(tt.want, locale); err != nil || got != date && !tt.partial {
+				t.Errorf("Pattern.ParseLocale(%q) = %v, %v, want %v", tt.want, got, err, date)
+			}
+		})
+	}
+}
+
+func TestPattern_Parse(t *testing.T) {
+	tests := []struct {
+		pattern string
+		value   string
+		want    Date
+		wantErr bool
+	}{
+		{pattern: "d.M.y", value: "5.3.2026", want: New(2026, time.March, 5)},
+		{pattern: "dd.MM.yyyy", value: "5.3.2026", want: New(2026, time.March, 5)},
+		{pattern: "yyyyMMdd", value: "2026035", wantErr: true},
+		{pattern: "yy-MM-dd", value: "68-01-01", want: New(2068, time.January, 1)},
+		{pattern: "yy-MM-dd", value: "69-01-01", want: New(1969, time.January, 1)},
+		{pattern: "yy-MM-dd", value: "2026-01-01", wantErr: true},
+		{pattern: "MMM y", value: "mar 2026", want: New(2026, time.March, 1)},
+		{pattern: "QQQ y", value: "Q3 2026", want: New(2026, time.July, 1)},
+		{pattern: "QQQ y", value: "Q5 2026", wantErr: true},
+		{pattern: "Y-'W'ww-EEE", value: "2020-W53-Sun", want: New(2021, time.January, 3)},
+		{pattern: "Y-'W'ww", value: "2021-W53", wantErr: true},
+		{pattern: "y-DDD", value: "2024-366", want: New(2024, time.December, 31)},
+		{pattern: "y-DDD", value: "2025-366", wantErr: true},
+		{pattern: "EEE d MMM y", value: "Mon 18 Oct 2026", wantErr: true},
+		{pattern: "d.M.y", value: "31.2.2026", wantErr: true},
+		{pattern: "d MMM y", value: "5 Mar 2026 ", wantErr: true},
+		{pattern: "d.M.y", value: "5-3-2026", wantErr: true},
+		{pattern: "d MMMM y", value: "5 Marchh 2026", wantErr: true},
+		{pattern: "y-M-d (M)", value: "2026-3-5 (4)", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.value, func(t *testing.T) {
+			pattern, err := CompileLDML(tt.pattern)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := pattern.Parse(tt.value)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("Pattern.Parse() = %v, %v, want %v, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPattern_Concurrent(t *testing.T) {
+	pattern, err := CompileLDML("EEEE, d MMMM y")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var group sync.WaitGroup
+	for _, locale := range []*Locale{Polish(), German(), French(), Spanish(), BritishEnglish(), AmericanEnglish()} {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			for date := range NewRange(New(2024, time.January, 1), New(2025, time.December, 31)).Days() {
+				value := pattern.FormatLocale(date, locale)
+				if got, err := pattern.ParseLocale(value, locale); err != nil || got != date {
+					t.Errorf("%s: Pattern.ParseLocale(%q) = %v, %v, want %v", locale.Tag, value, got, err, date)
+					return
+				}
+			}
+		}()
+	}
+	group.Wait()
+	if got := pattern.String(); got != "EEEE, d MMMM y" {
+		t.Errorf("Pattern.String() = %q", got)
+	}
+	if got := string(pattern.AppendFormat([]byte("on "), New(2026, time.October, 18))); got != "on Sunday, 18 October 2026" {
+		t.Errorf("Pattern.AppendFormat() = %q", got)
+	}
+}
@@ -0,0 +1,432 @@
+package date
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Represents an amount of calendar time expressed in years, months, weeks, and days.
+//
+// # Remarks
+//
+// The components are independent and can have different signs.
+// A period is not normalized unless Normalize is called, so P14M and P1Y2M are different values.
+type Period struct {
+	Years  int
+	Months int
+	Weeks  int
+	Days   int
+}
+
+// Creates a new instance of the Period structure to the specified years, months, and days.
+//
+// # Parameters
+//
+//	years int
+//
+// The number of years.
+//
+//	months int
+//
+// The number of months.
+//
+//	days int
+//
+// The number of days.
+//
+// # Returns
+//
+//	period Period
+//
+// A new instance of the Period structure to the specified years, months, and days.
+func NewPeriod(years int, months int, days int) (period Period) {
+	return Period{Years: years, Months: months, Days: days}
+}
+
+// Parses an ISO 8601 duration consisting of date components, such as P1Y2M10D or P3W.
+//
+// # Parameters
+//
+//	value string
+//
+// The ISO 8601 duration to parse.
+//
+// # Returns
+//
+//	period Period
+//
+// The parsed period.
+//
+//	err error
+//
+// An error if value is not a valid ISO 8601 duration or it contains time components.
+//
+// # Remarks
+//
+// A leading minus sign negates the whole period (-P1M) and each component can have its own sign (P1M-1D).
+// The designators must appear in the order Y, M, W, D and each of them at most once.
+func ParsePeriod(value string) (period Period, err error) {
+	s := value
+	negative := false
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+	if len(s) < 2 || s[0] != 'P' {
+		return Period{}, fmt.Errorf("date.ParsePeriod: invalid period %q", value)
+	}
+	s = s[1:]
+	const designators = "YMWD"
+	next := 0
+	for len(s) > 0 {
+		if s[0] == 'T' {
+			return Period{}, fmt.Errorf("date.ParsePeriod: time components are not supported in %q", value)
+		}
+		i := 0
+		if s[0] == '-' || s[0] == '+' {
+			i++
+		}
+		for i < len(s) && '0' <= s[i] && s[i] <= '9' {
+			i++
+		}
+		if i == len(s) {
+			return Period{}, fmt.Errorf("date.ParsePeriod: missing designator in %q", value)
+		}
+		n, err := strconv.Atoi(s[:i])
+		if err != nil {
+			return Period{}, fmt.Errorf("date.ParsePeriod: invalid number in %q: %w", value, err)
+		}
+		position := next
+		for position < len(designators) && designators[position] != s[i] {
+			position++
+		}
+		if position == len(designators) {
+			return Period{}, fmt.Errorf("date.ParsePeriod: unexpected designator %q in %q", s[i], value)
+		}
+		switch designators[position] {
+		case 'Y':
+			period.Years = n
+		case 'M':
+			period.Months = n
+		case 'W':
+			period.Weeks = n
+		case 'D':
+			period.Days = n
+		}
+		next = position + 1
+		s = s[i+1:]
+	}
+	if negative {
+		period = period.Negate()
+	}
+	return period, nil
+}
+
+// Parses a market tenor, such as 3M, 1Y, 2W, or 1Y6M.
+//
+// # Parameters
+//
+//	value string
+//
+// The tenor to parse; it is case-insensitive and may also be an ISO 8601 duration such as P3M.
+//
+// # Returns
+//
+//	period Period
+//
+// The parsed period.
+//
+//	err error
+//
+// An error if value is not a valid tenor.
+func ParseTenor(value string) (period Period, err error) {
+	s := strings.ToUpper(value)
+	if !strings.HasPrefix(s, "P") {
+		s = "P" + s
+	}
+	if period, err = ParsePeriod(s); err != nil || period.IsZero() {
+		return Period{}, fmt.Errorf("date.ParseTenor: invalid tenor %q", value)
+	}
+	return period, nil
+}
+
+// Adds period to the date.
+//
+// # Parameters
+//
+//	period Period
+//
+// Period to add to date.
+//
+// # Returns
+//
+//	result Date
+//
+// The date date + period.
+//
+// # Remarks
+//
+// It is shorthand for date.AddDate(period.Years, period.Months, 7*period.Weeks+period.Days).
+func (date Date) AddPeriod(period Period) (result Date) {
+	return date.AddDate(period.Years, period.Months, 7*period.Weeks+period.Days)
+}
+
+// Returns the period between start and end split into whole years, months, and days.
+//
+// # Parameters
+//
+//	start Date
+//
+// The start date.
+//
+//	end Date
+//
+// The end date.
+//
+// # Returns
+//
+//	period Period
+//
+// The period between start and end.
+//
+// # Remarks
+//
+// The result has no weeks and all its components have the same sign.
+// It satisfies start.AddPeriod(PeriodBetween(start, end)) == end.
+func PeriodBetween(start Date, end Date) (period Period) {
+	months := MonthsBetween(start, end)
+	days := start.AddMonths(months).DaysUntil(end)
+	return Period{Years: months / 12, Months: months % 12, Days: days}
+}
+
+// Returns the period with all components negated.
+//
+// # Returns
+//
+//	result Period
+//
+// The period with all components negated.
+func (period Period) Negate() (result Period) {
+	return Period{Years: -period.Years, Months: -period.Months, Weeks: -period.Weeks, Days: -period.Days}
+}
+
+// Returns the period with months folded into years and weeks folded into days.
+//
+// # Returns
+//
+//	result Period
+//
+// The normalized period.
+//
+// # Remarks
+//
+// Days are never folded into months, because a month has no fixed number of days.
+// P1Y14M3W normalizes to P2Y2M21D.
+func (period Period) Normalize() (result Period) {
+	months := 12*period.Years + period.Months
+	return Period{Years: months / 12, Months: months % 12, Days: 7*period.Weeks + period.Days}
+}
+
+// Reports whether all components of the period are zero.
+//
+// # Returns
+//
+//	result bool
+//
+// True if the period is zero, false otherwise.
+func (period Period) IsZero() (result bool) {
+	return period == Period{}
+}
+
+// Returns the period formatted as an ISO 8601 duration, for example P1Y2M10D.
+//
+// # Remarks
+//
+// The zero period is formatted as P0D.
+// If all non-zero components are negative, the period is formatted with a leading minus sign (-P1Y2M).
+func (period Period) String() string {
+	return string(period.appendText(nil))
+}
+
+func (period Period) appendText(bytes []byte) []byte {
+	if period.IsZero() {
+		return append(bytes, "P0D"...)
+	}
+	if period.Years <= 0 && period.Months <= 0 && period.Weeks <= 0 && period.Days <= 0 {
+		bytes = append(bytes, '-')
+		period = period.Negate()
+	}
+	bytes = append(bytes, 'P')
+	for _, component := range []struct {
+		value      int
+		designator byte
+	}{
+		{period.Years, 'Y'},
+		{period.Months, 'M'},
+		{period.Weeks, 'W'},
+		{period.Days, 'D'},
+	} {
+		if component.value != 0 {
+			bytes = strconv.AppendInt(bytes, int64(component.value), 10)
+			bytes = append(bytes, component.designator)
+		}
+	}
+	return bytes
+}
+
+// Implements the [encoding.TextAppender] interface.
+//
+// # Parameters
+//
+//	bytes []byte
+//
+// Array of bytes to add the ISO 8601 formatted period.
+//
+// # Returns
+//
+//	result []byte
+//
+// Array of bytes with added ISO 8601 formatted period.
+//
+//	err error
+//
+// nil value.
+func (period Period) AppendText(bytes []byte) (result []byte, err error) {
+	return period.appendText(bytes), nil
+}
+
+// Implements the [encoding.TextMarshaler] interface.
+//
+// # Returns
+//
+//	data []byte
+//
+// The period as an ISO 8601 duration.
+//
+//	err error
+//
+// nil value.
+func (period Period) MarshalText() (data []byte, err error) {
+	return period.appendText(nil), nil
+}
+
+// Implements the [encoding.TextUnmarshaler] interface.
+//
+// # Parameters
+//
+//	data []byte
+//
+// Text data.
+//
+// # Returns
+//
+//	err error
+//
+// Error when unmarshal problems, nil otherwise.
+//
+// # Remarks
+//
+// The period must be an ISO 8601 duration.
+func (period *Period) UnmarshalText(data []byte) error {
+	parsed, err := ParsePeriod(string(data))
+	if err != nil {
+		return err
+	}
+	*period = parsed
+	return nil
+}
+
+// Implements the [encoding/json.Marshaler] interface.
+//
+// # Returns
+//
+//	data []byte
+//
+// The period as a quoted ISO 8601 duration.
+//
+//	err error
+//
+// nil value.
+func (period Period) MarshalJSON() (data []byte, err error) {
+	data = append(period.appendText([]byte{'"'}), '"')
+	return data, nil
+}
+
+// Implements the [encoding/json.Unmarshaler] interface.
+//
+// # Parameters
+//
+//	data []byte
+//
+// JSON data.
+//
+// # Returns
+//
+//	err error
+//
+// Error when unmarshal problems, nil otherwise.
+//
+// # Remarks
+//
+// The period must be a quoted ISO 8601 duration.
+func (period *Period) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("date.Period.UnmarshalJSON: input is not a JSON string")
+	}
+	return period.UnmarshalText(data[1 : len(data)-1])
+}
+
+// Implements the [database/sql/driver.Valuer] interface.
+//
+// # Returns
+//
+//	value driver.Value
+//
+// The period as an ISO 8601 duration string.
+//
+//	err error
+//
+// nil value.
+func (period Period) Value() (value driver.Value, err error) {
+	return period.String(), nil
+}
+
+// Implements the [database/sql.Scanner] interface.
+//
+// # Parameters
+//
+//	value any
+//
+// Value from database to scan.
+//
+// # Returns
+//
+//	err error
+//
+// Database scan error.
+func (period *Period) Scan(value any) (err error) {
+	switch v := value.(type) {
+	case string:
+		parsed, err := ParsePeriod(v)
+		if err != nil {
+			return fmt.Errorf("Period.Scan: cannot parse string %q: %w", v, err)
+		}
+		*period = parsed
+		return nil
+	case []byte:
+		parsed, err := ParsePeriod(string(v))
+		if err != nil {
+			return fmt.Errorf("Period.Scan: cannot parse bytes %q: %w", v, err)
+		}
+		*period = parsed
+		return nil
+	default:
+		return fmt.Errorf("Period.Scan: unsupported type %T", value)
+	}
+}
@@ -0,0 +1,236 @@
+package date
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    Period
+		wantErr bool
+	}{
+		{
+			name:  "Years, months and days",
+			value: "P1Y2M10D",
+			want:  Period{Years: 1, Months: 2, Days: 10},
+		},
+		{
+			name:  "Weeks",
+			value: "P3W",
+			want:  Period{Weeks: 3},
+		},
+		{
+			name:  "Negative period",
+			value: "-P1M",
+			want:  Period{Months: -1},
+		},
+		{
+			name:  "Mixed signs",
+			value: "P1M-1D",
+			want:  Period{Months: 1, Days: -1},
+		},
+		{
+			name:  "Zero",
+			value: "P0D",
+			want:  Period{},
+		},
+		{
+			name:    "Empty",
+			value:   "P",
+			wantErr: true,
+		},
+		{
+			name:    "Time components",
+			value:   "P1DT2H",
+			wantErr: true,
+		},
+		{
+			name:    "Wrong order",
+			value:   "P1D1M",
+			wantErr: true,
+		},
+		{
+			name:    "Missing designator",
+			value:   "P12",
+			wantErr: true,
+		},
+		{
+			name:    "Missing number",
+			value:   "PY",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePeriod(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParsePeriod() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParsePeriod() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTenor(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Period
+		wantErr bool
+	}{
+		{value: "3M", want: Period{Months: 3}},
+		{value: "1y6m", want: Period{Years: 1, Months: 6}},
+		{value: "2W", want: Period{Weeks: 2}},
+		{value: "P28D", want: Period{Days: 28}},
+		{value: "0M", wantErr: true},
+		{value: "M", wantErr: true},
+		{value: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseTenor(tt.value)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseTenor() = %v, %v, want %v, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPeriod_String(t *testing.T) {
+	tests := []struct {
+		name   string
+		period Period
+		want   string
+	}{
+		{
+			name:   "Zero",
+			period: Period{},
+			want:   "P0D",
+		},
+		{
+			name:   "All components",
+			period: Period{Years: 1, Months: 2, Weeks: 3, Days: 4},
+			want:   "P1Y2M3W4D",
+		},
+		{
+			name:   "Negative",
+			period: Period{Years: -1, Days: -2},
+			want:   "-P1Y2D",
+		},
+		{
+			name:   "Mixed signs",
+			period: Period{Months: 1, Days: -1},
+			want:   "P1M-1D",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.String(); got != tt.want {
+				t.Errorf("Period.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriod_Normalize(t *testing.T) {
+	tests := []struct {
+		name   string
+		period Period
+		want   Period
+	}{
+		{
+			name:   "Months and weeks",
+			period: Period{Years: 1, Months: 14, Weeks: 3},
+			want:   Period{Years: 2, Months: 2, Days: 21},
+		},
+		{
+			name:   "Mixed signs",
+			period: Period{Years: 1, Months: -1},
+			want:   Period{Months: 11},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.Normalize(); got != tt.want {
+				t.Errorf("Period.Normalize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriodBetween(t *testing.T) {
+	tests := []struct {
+		name  string
+		start Date
+		end   Date
+		want  Period
+	}{
+		{
+			name:  "Same date",
+			start: New(2024, time.January, 31),
+			end:   New(2024, time.January, 31),
+			want:  Period{},
+		},
+		{
+			name:  "Years, months and days",
+			start: New(2020, time.March, 15),
+			end:   New(2024, time.May, 20),
+			want:  Period{Years: 4, Months: 2, Days: 5},
+		},
+		{
+			name:  "Day of end before day of start",
+			start: New(2024, time.January, 20),
+			end:   New(2024, time.March, 10),
+			want:  Period{Months: 1, Days: 19},
+		},
+		{
+			name:  "End of month",
+			start: New(2023, time.January, 31),
+			end:   New(2023, time.March, 1),
+			want:  Period{Days: 29},
+		},
+		{
+			name:  "Backwards",
+			start: New(2024, time.May, 20),
+			end:   New(2020, time.March, 15),
+			want:  Period{Years: -4, Months: -2, Days: -5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PeriodBetween(tt.start, tt.end)
+			if got != tt.want {
+				t.Errorf("PeriodBetween() = %v, want %v", got, tt.want)
+			}
+			if back := tt.start.AddPeriod(got); back != tt.end {
+				t.Errorf("Date.AddPeriod() = %v, want %v", back, tt.end)
+			}
+		})
+	}
+}
+
+func TestPeriod_JSON(t *testing.T) {
+	type contract struct {
+		Term Period `json:"term"`
+	}
+	data, err := json.Marshal(contract{Term: Period{Years: 1, Months: 6}})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if string(data) != `{"term":"P1Y6M"}` {
+		t.Errorf("json.Marshal() = %s, want %s", data, `{"term":"P1Y6M"}`)
+	}
+	var got contract
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if got.Term != (Period{Years: 1, Months: 6}) {
+		t.Errorf("json.Unmarshal() = %v, want %v", got.Term, Period{Years: 1, Months: 6})
+	}
+}
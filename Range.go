@@ -0,0 +1,360 @@
+package date
+
+import (
+	"iter"
+)
+
+// Represents a contiguous range of dates.
+//
+// # Remarks
+//
+// A range is stored as the half-open interval [start, end), where start is the first day of the range and end is the first day after it.
+// Use NewRange to create a range from inclusive bounds and NewHalfOpenRange to create a range with an exclusive end.
+// All empty ranges are represented by the zero value, so Range is comparable and two ranges are equal (==) when they contain the same days.
+type Range struct {
+	start Date
+	end   Date
+}
+
+// Creates a new instance of the Range structure containing the days from first to last, both inclusive.
+//
+// # Parameters
+//
+//	first Date
+//
+// The first day of the range.
+//
+//	last Date
+//
+// The last day of the range.
+//
+// # Returns
+//
+//	result Range
+//
+// The range [first, last]. If last is before first, the range is empty.
+func NewRange(first Date, last Date) (result Range) {
+	return NewHalfOpenRange(first, last.AddDays(1))
+}
+
+// Creates a new instance of the Range structure containing the days from start (inclusive) to end (exclusive).
+//
+// # Parameters
+//
+//	start Date
+//
+// The first day of the range.
+//
+//	end Date
+//
+// The first day after the range.
+//
+// # Returns
+//
+//	result Range
+//
+// The range [start, end). If end is not after start, the range is empty.
+func NewHalfOpenRange(start Date, end Date) (result Range) {
+	if !start.Before(end) {
+		return Range{}
+	}
+	return Range{start: start, end: end}
+}
+
+// Returns the first day of the range.
+//
+// # Returns
+//
+//	start Date
+//
+// The first day of the range.
+func (r Range) Start() (start Date) {
+	return r.start
+}
+
+// Returns the first day after the range (exclusive end).
+//
+// # Returns
+//
+//	end Date
+//
+// The first day after the range.
+func (r Range) End() (end Date) {
+	return r.end
+}
+
+// Returns the last day of the range (inclusive end).
+//
+// # Returns
+//
+//	last Date
+//
+// The last day of the range.
+//
+// # Remarks
+//
+// For an empty range, the day before the zero date is returned.
+func (r Range) Last() (last Date) {
+	return r.end.AddDays(-1)
+}
+
+// Reports whether the range contains no days.
+//
+// # Returns
+//
+//	result bool
+//
+// True if the range is empty, false otherwise.
+func (r Range) IsEmpty() (result bool) {
+	return r == Range{}
+}
+
+// Returns the number of days in the range.
+//
+// # Returns
+//
+//	days int
+//
+// The number of days in the range.
+func (r Range) Len() (days int) {
+	return r.start.DaysUntil(r.end)
+}
+
+// Reports whether the range contains date.
+//
+// # Parameters
+//
+//	date Date
+//
+// The date to check.
+//
+// # Returns
+//
+//	result bool
+//
+// True if date is in the range, false otherwise.
+func (r Range) Contains(date Date) (result bool) {
+	return !date.Before(r.start) && date.Before(r.end)
+}
+
+// Reports whether the range contains every day of other.
+//
+// # Parameters
+//
+//	other Range
+//
+// The range to check.
+//
+// # Returns
+//
+//	result bool
+//
+// True if other is a subset of the range, false otherwise. An empty range is contained in every range.
+func (r Range) ContainsRange(other Range) (result bool) {
+	if other.IsEmpty() {
+		return true
+	}
+	return !other.start.Before(r.start) && !other.end.After(r.end)
+}
+
+// Reports whether the range and other have at least one day in common.
+//
+// # Parameters
+//
+//	other Range
+//
+// The range to check.
+//
+// # Returns
+//
+//	result bool
+//
+// True if the ranges overlap, false otherwise.
+func (r Range) Overlaps(other Range) (result bool) {
+	return !r.IsEmpty() && !other.IsEmpty() && r.start.Before(other.end) && other.start.Before(r.end)
+}
+
+// Returns the days common to the range and other.
+//
+// # Parameters
+//
+//	other Range
+//
+// The range to intersect with.
+//
+// # Returns
+//
+//	result Range
+//
+// The intersection of the ranges; empty if they do not overlap.
+func (r Range) Intersect(other Range) (result Range) {
+	return NewHalfOpenRange(latest(r.start, other.start), earliest(r.end, other.end))
+}
+
+// Returns the range covering the days of the range and other.
+//
+// # Parameters
+//
+//	other Range
+//
+// The range to join with.
+//
+// # Returns
+//
+//	result Range
+//
+// The union of the ranges.
+//
+//	ok bool
+//
+// True if the union is a single range, that is the ranges overlap or are adjacent, or one of them is empty; false otherwise.
+func (r Range) Union(other Range) (result Range, ok bool) {
+	switch {
+	case other.IsEmpty():
+		return r, true
+	case r.IsEmpty():
+		return other, true
+	case r.start.After(other.end) || other.start.After(r.end):
+		return Range{}, false
+	}
+	return Range{start: earliest(r.start, other.start), end: latest(r.end, other.end)}, true
+}
+
+// Returns the days between the range and other.
+//
+// # Parameters
+//
+//	other Range
+//
+// The other range.
+//
+// # Returns
+//
+//	result Range
+//
+// The days after the earlier range and before the later one; empty if the ranges overlap or are adjacent.
+func (r Range) Gap(other Range) (result Range) {
+	if r.IsEmpty() || other.IsEmpty() {
+		return Range{}
+	}
+	if r.start.After(other.start) {
+		r, other = other, r
+	}
+	return NewHalfOpenRange(r.end, other.start)
+}
+
+// Returns the range shifted by the specified number of years, months, and days.
+//
+// # Parameters
+//
+//	years int
+//
+// The number of years to shift by.
+//
+//	months int
+//
+// The number of months to shift by.
+//
+//	days int
+//
+// The number of days to shift by.
+//
+// # Returns
+//
+//	result Range
+//
+// The range with both its start and its exclusive end shifted with [Date.AddDate].
+//
+// # Remarks
+//
+// Because the exclusive end is shifted, a range covering a whole month is shifted to the whole target month.
+func (r Range) AddDate(years int, months int, days int) (result Range) {
+	return NewHalfOpenRange(r.start.AddDate(years, months, days), r.end.AddDate(years, months, days))
+}
+
+// Returns the range shifted by the specified number of days.
+func (r Range) AddDays(days int) Range {
+	return NewHalfOpenRange(r.start.AddDays(days), r.end.AddDays(days))
+}
+
+// Returns the range shifted by the specified number of months.
+func (r Range) AddMonths(months int) Range {
+	return r.AddDate(0, months, 0)
+}
+
+// Returns the range shifted by the specified number of years.
+func (r Range) AddYears(years int) Range {
+	return r.AddDate(years, 0, 0)
+}
+
+// Returns an iterator over the days of the range in ascending order.
+//
+// # Returns
+//
+//	seq iter.Seq[Date]
+//
+// The iterator over the days of the range.
+func (r Range) Days() (seq iter.Seq[Date]) {
+	return func(yield func(Date) bool) {
+		for date := r.start; date.Before(r.end); date = date.AddDays(1) {
+			if !yield(date) {
+				return
+			}
+		}
+	}
+}
+
+// Returns an iterator over the dates start, start+step, start+2*step, ... that are in the range.
+//
+// # Parameters
+//
+//	step Period
+//
+// The distance between consecutive dates.
+//
+// # Returns
+//
+//	seq iter.Seq[Date]
+//
+// The iterator over the dates of the range.
+//
+// # Remarks
+//
+// The n-th date is computed as start.AddPeriod(n*step) rather than by repeated addition,
+// so a monthly step from January 31 does not drift to the 28th.
+// The iteration stops at the first date that is outside the range or not after the previous date,
+// so a step that does not move forward, such as P0D, -P1D or P1M-40D from January 1, yields only start.
+// The iteration over an empty range yields no dates.
+func (r Range) Step(step Period) (seq iter.Seq[Date]) {
+	return func(yield func(Date) bool) {
+		previous := r.start
+		for n := 0; ; n++ {
+			date := r.start.AddDate(n*step.Years, n*step.Months, n*(7*step.Weeks+step.Days))
+			if date.Before(r.start) || !date.Before(r.end) || n > 0 && !date.After(previous) || !yield(date) {
+				return
+			}
+			previous = date
+		}
+	}
+}
+
+// Returns the range formatted as a half-open interval, for example [2024-01-01, 2024-02-01).
+func (r Range) String() string {
+	return "[" + r.start.String() + ", " + r.end.String() + ")"
+}
+
+// Returns the earlier of a and b.
+func earliest(a Date, b Date) Date {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+// Returns the later of a and b.
+func latest(a Date, b Date) Date {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
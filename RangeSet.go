@@ -0,0 +1,312 @@
+package date
+
+import (
+	"iter"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Represents a set of dates stored as a sorted list of disjoint, non-adjacent ranges.
+//
+// # Remarks
+//
+// The zero value is an empty set.
+// A RangeSet never shares its ranges with another set, so copies of a RangeSet can be modified independently.
+type RangeSet struct {
+	ranges []Range
+}
+
+// Creates a new instance of the RangeSet structure containing the days of ranges.
+//
+// # Parameters
+//
+//	ranges ...Range
+//
+// The ranges to add to the set. They can be unsorted, overlapping, or empty.
+//
+// # Returns
+//
+//	set RangeSet
+//
+// The set containing every day of ranges.
+func NewRangeSet(ranges ...Range) (set RangeSet) {
+	sorted := slices.DeleteFunc(slices.Clone(ranges), Range.IsEmpty)
+	slices.SortFunc(sorted, func(a Range, b Range) int {
+		return a.start.Compare(b.start)
+	})
+	merged := sorted[:0]
+	for _, r := range sorted {
+		merged = appendMerged(merged, r)
+	}
+	return RangeSet{ranges: slices.Clip(merged)}
+}
+
+// Returns an iterator over the disjoint ranges of the set in ascending order.
+//
+// # Returns
+//
+//	seq iter.Seq[Range]
+//
+// The iterator over the ranges of the set.
+func (set RangeSet) Ranges() (seq iter.Seq[Range]) {
+	return slices.Values(set.ranges)
+}
+
+// Returns an iterator over the days of the set in ascending order.
+//
+// # Returns
+//
+//	seq iter.Seq[Date]
+//
+// The iterator over the days of the set.
+func (set RangeSet) Days() (seq iter.Seq[Date]) {
+	return func(yield func(Date) bool) {
+		for _, r := range set.ranges {
+			for date := range r.Days() {
+				if !yield(date) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Reports whether the set contains no days.
+//
+// # Returns
+//
+//	result bool
+//
+// True if the set is empty, false otherwise.
+func (set RangeSet) IsEmpty() (result bool) {
+	return len(set.ranges) == 0
+}
+
+// Returns the total number of days in the set.
+//
+// # Returns
+//
+//	days int
+//
+// The number of days in the set.
+func (set RangeSet) Len() (days int) {
+	for _, r := range set.ranges {
+		days += r.Len()
+	}
+	return days
+}
+
+// Reports whether the set contains date.
+//
+// # Parameters
+//
+//	date Date
+//
+// The date to check.
+//
+// # Returns
+//
+//	result bool
+//
+// True if date is in the set, false otherwise.
+//
+// # Remarks
+//
+// The lookup is a binary search over the ranges of the set.
+func (set RangeSet) Contains(date Date) (result bool) {
+	i := sort.Search(len(set.ranges), func(i int) bool {
+		return date.Before(set.ranges[i].end)
+	})
+	return i < len(set.ranges) && set.ranges[i].Contains(date)
+}
+
+// Adds the days of r to the set.
+//
+// # Parameters
+//
+//	r Range
+//
+// The range to add.
+func (set *RangeSet) Add(r Range) {
+	if r.IsEmpty() {
+		return
+	}
+	// Ranges [i, j) overlap or touch r and are merged with it.
+	i := sort.Search(len(set.ranges), func(i int) bool {
+		return !set.ranges[i].end.Before(r.start)
+	})
+	j := sort.Search(len(set.ranges), func(j int) bool {
+		return set.ranges[j].start.After(r.end)
+	})
+	if i < j {
+		r.start = earliest(r.start, set.ranges[i].start)
+		r.end = latest(r.end, set.ranges[j-1].end)
+	}
+	set.ranges = slices.Concat(set.ranges[:i], []Range{r}, set.ranges[j:])
+}
+
+// Removes the days of r from the set.
+//
+// # Parameters
+//
+//	r Range
+//
+// The range to remove.
+func (set *RangeSet) Remove(r Range) {
+	if r.IsEmpty() {
+		return
+	}
+	// Ranges [i, j) overlap r; only their parts outside r are kept.
+	i := sort.Search(len(set.ranges), func(i int) bool {
+		return r.start.Before(set.ranges[i].end)
+	})
+	j := sort.Search(len(set.ranges), func(j int) bool {
+		return !set.ranges[j].start.Before(r.end)
+	})
+	if i == j {
+		return
+	}
+	kept := make([]Range, 0, 2)
+	if before := NewHalfOpenRange(set.ranges[i].start, r.start); !before.IsEmpty() {
+		kept = append(kept, before)
+	}
+	if after := NewHalfOpenRange(r.end, set.ranges[j-1].end); !after.IsEmpty() {
+		kept = append(kept, after)
+	}
+	set.ranges = slices.Concat(set.ranges[:i], kept, set.ranges[j:])
+}
+
+// Returns the set of days that are in the set or in other.
+//
+// # Parameters
+//
+//	other RangeSet
+//
+// The set to join with.
+//
+// # Returns
+//
+//	result RangeSet
+//
+// The union of the sets.
+func (set RangeSet) Union(other RangeSet) (result RangeSet) {
+	merged := make([]Range, 0, len(set.ranges)+len(other.ranges))
+	a, b := set.ranges, other.ranges
+	for len(a) > 0 || len(b) > 0 {
+		if len(b) == 0 || (len(a) > 0 && a[0].start.Before(b[0].start)) {
+			merged = appendMerged(merged, a[0])
+			a = a[1:]
+		} else {
+			merged = appendMerged(merged, b[0])
+			b = b[1:]
+		}
+	}
+	return RangeSet{ranges: merged}
+}
+
+// Returns the set of days that are both in the set and in other.
+//
+// # Parameters
+//
+//	other RangeSet
+//
+// The set to intersect with.
+//
+// # Returns
+//
+//	result RangeSet
+//
+// The intersection of the sets.
+func (set RangeSet) Intersect(other RangeSet) (result RangeSet) {
+	var common []Range
+	a, b := set.ranges, other.ranges
+	for len(a) > 0 && len(b) > 0 {
+		if r := a[0].Intersect(b[0]); !r.IsEmpty() {
+			common = append(common, r)
+		}
+		if a[0].end.Before(b[0].end) {
+			a = a[1:]
+		} else {
+			b = b[1:]
+		}
+	}
+	return RangeSet{ranges: common}
+}
+
+// Returns the set of days that are in the set but not in other.
+//
+// # Parameters
+//
+//	other RangeSet
+//
+// The set of days to remove.
+//
+// # Returns
+//
+//	result RangeSet
+//
+// The difference of the sets.
+func (set RangeSet) Difference(other RangeSet) (result RangeSet) {
+	var rest []Range
+	b := other.ranges
+	for _, r := range set.ranges {
+		// Skip the ranges of other that end before r starts; they cannot affect the following ranges either.
+		for len(b) > 0 && !r.start.Before(b[0].end) {
+			b = b[1:]
+		}
+		for _, cut := range b {
+			if !cut.start.Before(r.end) {
+				break
+			}
+			if cut.start.After(r.start) {
+				rest = append(rest, Range{start: r.start, end: cut.start})
+			}
+			r.start = cut.end
+		}
+		if r.start.Before(r.end) {
+			rest = append(rest, r)
+		}
+	}
+	return RangeSet{ranges: rest}
+}
+
+// Returns the set of days within bounds that are not in the set.
+//
+// # Parameters
+//
+//	bounds Range
+//
+// The range to complement the set within.
+//
+// # Returns
+//
+//	result RangeSet
+//
+// The days of bounds that are not in the set.
+func (set RangeSet) Complement(bounds Range) (result RangeSet) {
+	return NewRangeSet(bounds).Difference(set)
+}
+
+// Returns the set formatted as a list of half-open ranges, for example {[2024-01-01, 2024-02-01), [2024-03-01, 2024-04-01)}.
+func (set RangeSet) String() string {
+	var builder strings.Builder
+	builder.WriteByte('{')
+	for i, r := range set.ranges {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(r.String())
+	}
+	builder.WriteByte('}')
+	return builder.String()
+}
+
+// Appends r to ranges sorted by start, merging it with the last range if they overlap or touch.
+func appendMerged(ranges []Range, r Range) []Range {
+	if n := len(ranges); n > 0 && !ranges[n-1].end.Before(r.start) {
+		ranges[n-1].end = latest(ranges[n-1].end, r.end)
+		return ranges
+	}
+	return append(ranges, r)
+}
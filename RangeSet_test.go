@@ -0,0 +1,155 @@
+package date
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+	"time"
+)
+
+func jan(first int, last int) Range {
+	return NewRange(New(2024, time.January, first), New(2024, time.January, last))
+}
+
+func TestNewRangeSet(t *testing.T) {
+	tests := []struct {
+		name   string
+		ranges []Range
+		want   []Range
+	}{
+		{
+			name:   "Empty",
+			ranges: nil,
+			want:   nil,
+		},
+		{
+			name:   "Unsorted and overlapping",
+			ranges: []Range{jan(10, 15), jan(1, 5), jan(4, 8)},
+			want:   []Range{jan(1, 8), jan(10, 15)},
+		},
+		{
+			name:   "Adjacent",
+			ranges: []Range{jan(1, 5), jan(6, 10)},
+			want:   []Range{jan(1, 10)},
+		},
+		{
+			name:   "Empty ranges",
+			ranges: []Range{{}, jan(3, 1), jan(1, 1)},
+			want:   []Range{jan(1, 1)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.Collect(NewRangeSet(tt.ranges...).Ranges()); !slices.Equal(got, tt.want) {
+				t.Errorf("NewRangeSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangeSet_AddRemove(t *testing.T) {
+	set := NewRangeSet(jan(1, 5), jan(10, 15), jan(20, 25))
+	copied := set
+	set.Add(jan(6, 9))
+	if got, want := slices.Collect(set.Ranges()), []Range{jan(1, 15), jan(20, 25)}; !slices.Equal(got, want) {
+		t.Errorf("RangeSet.Add() = %v, want %v", got, want)
+	}
+	set.Remove(jan(3, 21))
+	if got, want := slices.Collect(set.Ranges()), []Range{jan(1, 2), jan(22, 25)}; !slices.Equal(got, want) {
+		t.Errorf("RangeSet.Remove() = %v, want %v", got, want)
+	}
+	if got, want := slices.Collect(copied.Ranges()), []Range{jan(1, 5), jan(10, 15), jan(20, 25)}; !slices.Equal(got, want) {
+		t.Errorf("copy of RangeSet = %v, want %v", got, want)
+	}
+}
+
+func TestRangeSet_Algebra(t *testing.T) {
+	policies := NewRangeSet(jan(1, 10), jan(15, 31))
+	suspensions := NewRangeSet(jan(5, 16), jan(30, 31))
+	tests := []struct {
+		name string
+		got  RangeSet
+		want []Range
+	}{
+		{
+			name: "Union",
+			got:  policies.Union(suspensions),
+			want: []Range{jan(1, 31)},
+		},
+		{
+			name: "Intersect",
+			got:  policies.Intersect(suspensions),
+			want: []Range{jan(5, 10), jan(15, 16), jan(30, 31)},
+		},
+		{
+			name: "Difference",
+			got:  policies.Difference(suspensions),
+			want: []Range{jan(1, 4), jan(17, 29)},
+		},
+		{
+			name: "Complement",
+			got:  policies.Complement(NewRange(New(2023, time.December, 30), New(2024, time.January, 12))),
+			want: []Range{NewRange(New(2023, time.December, 30), New(2023, time.December, 31)), jan(11, 12)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.Collect(tt.got.Ranges()); !slices.Equal(got, tt.want) {
+				t.Errorf("RangeSet.%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+	if got := policies.Difference(suspensions).Len(); got != 17 {
+		t.Errorf("RangeSet.Len() = %v, want %v", got, 17)
+	}
+}
+
+func TestRangeSet_Model(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+	base := New(2024, time.January, 1)
+	randomSet := func() (RangeSet, map[Date]bool) {
+		var ranges []Range
+		days := map[Date]bool{}
+		for range random.IntN(6) {
+			r := NewHalfOpenRange(base.AddDays(random.IntN(60)), base.AddDays(random.IntN(60)))
+			ranges = append(ranges, r)
+			for date := range r.Days() {
+				days[date] = true
+			}
+		}
+		return NewRangeSet(ranges...), days
+	}
+	for range 500 {
+		a, aDays := randomSet()
+		b, bDays := randomSet()
+		union, intersect, difference := a.Union(b), a.Intersect(b), a.Difference(b)
+		added := a
+		for r := range b.Ranges() {
+			added.Add(r)
+		}
+		removed := a
+		for r := range b.Ranges() {
+			removed.Remove(r)
+		}
+		for date := range NewHalfOpenRange(base.AddDays(-1), base.AddDays(61)).Days() {
+			if got, want := a.Contains(date), aDays[date]; got != want {
+				t.Fatalf("%v.Contains(%v) = %v, want %v", a, date, got, want)
+			}
+			if got, want := union.Contains(date), aDays[date] || bDays[date]; got != want || added.Contains(date) != want {
+				t.Fatalf("%v.Union(%v).Contains(%v) = %v, want %v", a, b, date, got, want)
+			}
+			if got, want := intersect.Contains(date), aDays[date] && bDays[date]; got != want {
+				t.Fatalf("%v.Intersect(%v).Contains(%v) = %v, want %v", a, b, date, got, want)
+			}
+			if got, want := difference.Contains(date), aDays[date] && !bDays[date]; got != want || removed.Contains(date) != want {
+				t.Fatalf("%v.Difference(%v).Contains(%v) = %v, want %v", a, b, date, got, want)
+			}
+		}
+		if !slices.Equal(slices.Collect(union.Ranges()), slices.Collect(added.Ranges())) {
+			t.Fatalf("RangeSet.Add() = %v, want %v", added, union)
+		}
+		if !slices.Equal(slices.Collect(difference.Ranges()), slices.Collect(removed.Ranges())) {
+			t.Fatalf("RangeSet.Remove() = %v, want %v", removed, difference)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package date
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestNewRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		r         Range
+		wantLen   int
+		wantEmpty bool
+	}{
+		{
+			name:    "Inclusive",
+			r:       NewRange(New(2024, time.January, 1), New(2024, time.January, 31)),
+			wantLen: 31,
+		},
+		{
+			name:    "Single day",
+			r:       NewRange(New(2024, time.January, 1), New(2024, time.January, 1)),
+			wantLen: 1,
+		},
+		{
+			name:    "Half-open",
+			r:       NewHalfOpenRange(New(2024, time.January, 1), New(2024, time.February, 1)),
+			wantLen: 31,
+		},
+		{
+			name:      "Reversed",
+			r:         NewRange(New(2024, time.January, 2), New(2024, time.January, 1)),
+			wantLen:   0,
+			wantEmpty: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Len(); got != tt.wantLen {
+				t.Errorf("Range.Len() = %v, want %v", got, tt.wantLen)
+			}
+			if got := tt.r.IsEmpty(); got != tt.wantEmpty {
+				t.Errorf("Range.IsEmpty() = %v, want %v", got, tt.wantEmpty)
+			}
+		})
+	}
+}
+
+func TestRange_Contains(t *testing.T) {
+	r := NewRange(New(2024, time.January, 10), New(2024, time.January, 20))
+	tests := []struct {
+		name string
+		date Date
+		want bool
+	}{
+		{name: "Before", date: New(2024, time.January, 9), want: false},
+		{name: "First", date: New(2024, time.January, 10), want: true},
+		{name: "Last", date: New(2024, time.January, 20), want: true},
+		{name: "After", date: New(2024, time.January, 21), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := r.Contains(tt.date); got != tt.want {
+				t.Errorf("Range.Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRange_SetOperations(t *testing.T) {
+	january := NewRange(New(2024, time.January, 1), New(2024, time.January, 31))
+	tests := []struct {
+		name          string
+		other         Range
+		wantOverlaps  bool
+		wantIntersect Range
+		wantUnion     Range
+		wantUnionOk   bool
+		wantGap       Range
+	}{
+		{
+			name:          "Overlapping",
+			other:         NewRange(New(2024, time.January, 20), New(2024, time.February, 10)),
+			wantOverlaps:  true,
+			wantIntersect: NewRange(New(2024, time.January, 20), New(2024, time.January, 31)),
+			wantUnion:     NewRange(New(2024, time.January, 1), New(2024, time.February, 10)),
+			wantUnionOk:   true,
+		},
+		{
+			name:          "Adjacent",
+			other:         NewRange(New(2024, time.February, 1), New(2024, time.February, 29)),
+			wantOverlaps:  false,
+			wantIntersect: Range{},
+			wantUnion:     NewRange(New(2024, time.January, 1), New(2024, time.February, 29)),
+			wantUnionOk:   true,
+			wantGap:       Range{},
+		},
+		{
+			name:          "Disjoint",
+			other:         NewRange(New(2024, time.March, 1), New(2024, time.March, 31)),
+			wantOverlaps:  false,
+			wantIntersect: Range{},
+			wantUnionOk:   false,
+			wantGap:       NewRange(New(2024, time.February, 1), New(2024, time.February, 29)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := january.Overlaps(tt.other); got != tt.wantOverlaps {
+				t.Errorf("Range.Overlaps() = %v, want %v", got, tt.wantOverlaps)
+			}
+			if got := january.Intersect(tt.other); got != tt.wantIntersect {
+				t.Errorf("Range.Intersect() = %v, want %v", got, tt.wantIntersect)
+			}
+			got, ok := january.Union(tt.other)
+			if ok != tt.wantUnionOk || got != tt.wantUnion {
+				t.Errorf("Range.Union() = %v, %v, want %v, %v", got, ok, tt.wantUnion, tt.wantUnionOk)
+			}
+			if got := tt.other.Gap(january); got != tt.wantGap {
+				t.Errorf("Range.Gap() = %v, want %v", got, tt.wantGap)
+			}
+		})
+	}
+}
+
+func TestRange_AddMonths(t *testing.T) {
+	january := NewRange(New(2024, time.January, 1), New(2024, time.January, 31))
+	want := NewRange(New(2024, time.February, 1), New(2024, time.February, 29))
+	if got := january.AddMonths(1); got != want {
+		t.Errorf("Range.AddMonths() = %v, want %v", got, want)
+	}
+}
+
+func TestRange_Days(t *testing.T) {
+	r := NewRange(New(2024, time.February, 27), New(2024, time.March, 1))
+	want := []Date{
+		New(2024, time.February, 27),
+		New(2024, time.February, 28),
+		New(2024, time.February, 29),
+		New(2024, time.March, 1),
+	}
+	if got := slices.Collect(r.Days()); !slices.Equal(got, want) {
+		t.Errorf("Range.Days() = %v, want %v", got, want)
+	}
+}
+
+func TestRange_Step(t *testing.T) {
+	r := NewRange(New(2023, time.January, 31), New(2023, time.June, 30))
+	want := []Date{
+		New(2023, time.January, 31),
+		New(2023, time.March, 3),
+		New(2023, time.March, 31),
+		New(2023, time.May, 1),
+		New(2023, time.May, 31),
+	}
+	if got := slices.Collect(r.Step(Period{Months: 1})); !slices.Equal(got, want) {
+		t.Errorf("Range.Step() = %v, want %v", got, want)
+	}
+	tests := []struct {
+		name string
+		step Period
+		want []Date
+	}{
+		{name: "Zero", step: Period{}, want: []Date{New(2023, time.January, 31)}},
+		{name: "Negative", step: Period{Days: -1}, want: []Date{New(2023, time.January, 31)}},
+		{name: "Mixed sign backward", step: Period{Months: 1, Days: -40}, want: []Date{New(2023, time.January, 31)}},
+		{name: "Weeks", step: Period{Weeks: 8}, want: []Date{New(2023, time.January, 31), New(2023, time.March, 28), New(2023, time.May, 23)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.Collect(r.Step(tt.step)); !slices.Equal(got, tt.want) {
+				t.Errorf("Range.Step() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	// January 1 plus 1 month minus 29 days is January 3, plus 2 months minus 58 days is January 2.
+	r = NewRange(New(2023, time.January, 1), New(2023, time.December, 31))
+	want = []Date{New(2023, time.January, 1), New(2023, time.January, 3)}
+	if got := slices.Collect(r.Step(Period{Months: 1, Days: -29})); !slices.Equal(got, want) {
+		t.Errorf("Range.Step() = %v, want %v", got, want)
+	}
+	if got := slices.Collect(NewRange(New(2023, time.January, 31), New(2023, time.January, 1)).Step(Period{Days: 1})); len(got) != 0 {
+		t.Errorf("Range.Step() = %v, want no dates", got)
+	}
+}
@@ -0,0 +1,637 @@
+package date
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Specifies how often a recurrence repeats.
+type Frequency uint8
+
+const (
+	// The recurrence repeats every day.
+	Daily Frequency = iota + 1
+	// The recurrence repeats every week.
+	Weekly
+	// The recurrence repeats every month.
+	Monthly
+	// The recurrence repeats every year.
+	Yearly
+)
+
+var frequencyNames = [...]string{Daily: "DAILY", Weekly: "WEEKLY", Monthly: "MONTHLY", Yearly: "YEARLY"}
+
+// Returns the RFC 5545 name of the frequency, for example MONTHLY.
+func (frequency Frequency) String() string {
+	if frequency >= Daily && int(frequency) < len(frequencyNames) {
+		return frequencyNames[frequency]
+	}
+	return fmt.Sprintf("Frequency(%d)", uint8(frequency))
+}
+
+// Represents a day of the week in a recurrence rule, optionally with its occurrence within the month or year, for example -1FR for the last Friday.
+type RecurrenceDay struct {
+	// The day of the week.
+	Weekday time.Weekday
+	// The occurrence of Weekday: 0 for every occurrence, 1 for the first, -1 for the last, and so on.
+	N int
+}
+
+var weekdayCodes = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// Returns the day in the RFC 5545 format, for example -1FR.
+func (day RecurrenceDay) String() string {
+	if day.N == 0 {
+		return weekdayCodes[day.Weekday]
+	}
+	return strconv.Itoa(day.N) + weekdayCodes[day.Weekday]
+}
+
+// Represents an RFC 5545 recurrence of all-day dates, that is an RRULE together with its DTSTART, RDATE and EXDATE properties.
+//
+// # Remarks
+//
+// As RFC 5545 requires, Start is always the first occurrence, even if it does not match the rule.
+// Count bounds the occurrences of the rule (including Start); the dates of RDates are then added and the dates of ExDates removed.
+// Occurrences are not generated after December 31, 9999.
+type Recurrence struct {
+	// The first occurrence (DTSTART).
+	Start Date
+	// The frequency of the rule (FREQ).
+	Frequency Frequency
+	// The number of frequency periods between occurrences (INTERVAL); 0 is treated as 1.
+	Interval int
+	// The number of occurrences of the rule (COUNT); 0 if not bounded.
+	Count int
+	// The last possible occurrence of the rule (UNTIL); the zero date if not bounded.
+	Until Date
+	// The days of the week (BYDAY).
+	ByDay []RecurrenceDay
+	// The days of the month (BYMONTHDAY); negative values count from the end of the month.
+	ByMonthDay []int
+	// The months (BYMONTH).
+	ByMonth []time.Month
+	// The positions within the occurrences of each period (BYSETPOS); negative values count from the end.
+	BySetPos []int
+	// The first day of the week (WKST); it affects weekly rules with an interval greater than 1.
+	WeekStart time.Weekday
+	// Additional occurrences (RDATE).
+	RDates []Date
+	// Excluded occurrences (EXDATE).
+	ExDates []Date
+}
+
+// Parses an RFC 5545 recurrence.
+//
+// # Parameters
+//
+//	value string
+//
+// The recurrence: either an RRULE value such as FREQ=MONTHLY;BYDAY=-1FR, optionally prefixed with RRULE:,
+// or several content lines with DTSTART, RRULE, RDATE, and EXDATE properties separated by line breaks.
+//
+// # Returns
+//
+//	recurrence Recurrence
+//
+// The parsed recurrence.
+//
+//	err error
+//
+// An error if value is not a valid recurrence or it uses rule parts that do not apply to dates, such as BYHOUR.
+//
+// # Remarks
+//
+// If value has no DTSTART, Start must be set before the recurrence is evaluated.
+// Date-time values of DTSTART, UNTIL, RDATE, and EXDATE are truncated to their date.
+// BYWEEKNO and BYYEARDAY are not supported.
+func ParseRecurrence(value string) (recurrence Recurrence, err error) {
+	recurrence.WeekStart = time.Monday
+	value = strings.ReplaceAll(value, "\r\n", "\n")
+	if !strings.Contains(value, ":") {
+		value = "RRULE:" + value
+	}
+	hasRule := false
+	for _, line := range strings.Split(value, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		name, _, content, err := parseContentLine(line)
+		if err != nil {
+			return Recurrence{}, fmt.Errorf("date.ParseRecurrence: %w", err)
+		}
+		switch name {
+		case "DTSTART":
+			if recurrence.Start, err = parseRecurrenceDate(content); err != nil {
+				return Recurrence{}, fmt.Errorf("date.ParseRecurrence: DTSTART: %w", err)
+			}
+		case "RRULE":
+			if hasRule {
+				return Recurrence{}, errors.New("date.ParseRecurrence: more than one RRULE")
+			}
+			hasRule = true
+			if err := recurrence.parseRule(content); err != nil {
+				return Recurrence{}, fmt.Errorf("date.ParseRecurrence: %w", err)
+			}
+		case "RDATE", "EXDATE":
+			for _, item := range strings.Split(content, ",") {
+				date, err := parseRecurrenceDate(item)
+				if err != nil {
+					return Recurrence{}, fmt.Errorf("date.ParseRecurrence: %s: %w", name, err)
+				}
+				if name == "RDATE" {
+					recurrence.RDates = append(recurrence.RDates, date)
+				} else {
+					recurrence.ExDates = append(recurrence.ExDates, date)
+				}
+			}
+		default:
+			return Recurrence{}, fmt.Errorf("date.ParseRecurrence: unsupported property %s", name)
+		}
+	}
+	if !hasRule {
+		return Recurrence{}, errors.New("date.ParseRecurrence: missing RRULE")
+	}
+	return recurrence, nil
+}
+
+// Parses the value of an RRULE property into recurrence.
+func (recurrence *Recurrence) parseRule(rule string) (err error) {
+	hasCount, hasUntil := false, false
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return fmt.Errorf("invalid rule part %q", part)
+		}
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			switch i := slices.Index(frequencyNames[:], strings.ToUpper(value)); {
+			case i > 0:
+				recurrence.Frequency = Frequency(i)
+			case slices.Contains([]string{"SECONDLY", "MINUTELY", "HOURLY"}, strings.ToUpper(value)):
+				return fmt.Errorf("frequency %s does not apply to dates", value)
+			default:
+				return fmt.Errorf("invalid frequency %q", value)
+			}
+		case "INTERVAL":
+			if recurrence.Interval, err = parseRulePart(value, 1, 1<<30); err != nil {
+				return fmt.Errorf("INTERVAL: %w", err)
+			}
+		case "COUNT":
+			if recurrence.Count, err = parseRulePart(value, 1, 1<<30); err != nil {
+				return fmt.Errorf("COUNT: %w", err)
+			}
+			hasCount = true
+		case "UNTIL":
+			if recurrence.Until, err = parseRecurrenceDate(value); err != nil {
+				return fmt.Errorf("UNTIL: %w", err)
+			}
+			hasUntil = true
+		case "BYDAY":
+			recurrence.ByDay = nil
+			for _, item := range strings.Split(value, ",") {
+				day, err := parseRecurrenceDay(item)
+				if err != nil {
+					return fmt.Errorf("BYDAY: %w", err)
+				}
+				recurrence.ByDay = append(recurrence.ByDay, day)
+			}
+		case "BYMONTHDAY":
+			if recurrence.ByMonthDay, err = parseRuleList(value, 31); err != nil {
+				return fmt.Errorf("BYMONTHDAY: %w", err)
+			}
+		case "BYMONTH":
+			months, err := parseRuleList(value, 12)
+			if err != nil || slices.ContainsFunc(months, func(month int) bool { return month < 0 }) {
+				return fmt.Errorf("BYMONTH: invalid value %q", value)
+			}
+			recurrence.ByMonth = nil
+			for _, month := range months {
+				recurrence.ByMonth = append(recurrence.ByMonth, time.Month(month))
+			}
+		case "BYSETPOS":
+			if recurrence.BySetPos, err = parseRuleList(value, 366); err != nil {
+				return fmt.Errorf("BYSETPOS: %w", err)
+			}
+		case "WKST":
+			i := slices.Index(weekdayCodes[:], strings.ToUpper(value))
+			if i < 0 {
+				return fmt.Errorf("WKST: invalid weekday %q", value)
+			}
+			recurrence.WeekStart = time.Weekday(i)
+		case "BYSECOND", "BYMINUTE", "BYHOUR":
+			return fmt.Errorf("rule part %s does not apply to dates", key)
+		default:
+			return fmt.Errorf("unsupported rule part %s", key)
+		}
+	}
+	switch {
+	case recurrence.Frequency == 0:
+		return errors.New("missing FREQ")
+	case hasCount && hasUntil:
+		return errors.New("COUNT and UNTIL cannot be used together")
+	case recurrence.Frequency != Monthly && recurrence.Frequency != Yearly && slices.ContainsFunc(recurrence.ByDay, func(day RecurrenceDay) bool { return day.N != 0 }):
+		return fmt.Errorf("BYDAY ordinals cannot be used with FREQ=%s", recurrence.Frequency)
+	}
+	return nil
+}
+
+// Parses an integer rule part in the range [minimum, maximum].
+func parseRulePart(value string, minimum int, maximum int) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < minimum || n > maximum {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	return n, nil
+}
+
+// Parses a comma-separated list of non-zero integers in the range [-maximum, maximum].
+func parseRuleList(value string, maximum int) ([]int, error) {
+	var list []int
+	for _, item := range strings.Split(value, ",") {
+		n, err := parseRulePart(strings.TrimPrefix(item, "+"), -maximum, maximum)
+		if err != nil || n == 0 {
+			return nil, fmt.Errorf("invalid value %q", item)
+		}
+		list = append(list, n)
+	}
+	return list, nil
+}
+
+// Parses a BYDAY item such as MO, +2TU, or -1FR.
+func parseRecurrenceDay(value string) (day RecurrenceDay, err error) {
+	if len(value) < 2 {
+		return RecurrenceDay{}, fmt.Errorf("invalid weekday %q", value)
+	}
+	i := slices.Index(weekdayCodes[:], strings.ToUpper(value[len(value)-2:]))
+	if i < 0 {
+		return RecurrenceDay{}, fmt.Errorf("invalid weekday %q", value)
+	}
+	day.Weekday = time.Weekday(i)
+	if ordinal := value[:len(value)-2]; ordinal != "" {
+		if day.N, err = parseRulePart(strings.TrimPrefix(ordinal, "+"), -53, 53); err != nil || day.N == 0 {
+			return RecurrenceDay{}, fmt.Errorf("invalid weekday %q", value)
+		}
+	}
+	return day, nil
+}
+
+// Parses a DATE or DATE-TIME value, keeping only its date.
+func parseRecurrenceDate(value string) (Date, error) {
+	if len(value) != 8 && (len(value) < 15 || value[8] != 'T') {
+		return Date{}, fmt.Errorf("invalid date %q", value)
+	}
+	date, err := Parse("20060102", value[:8])
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q", value)
+	}
+	return date, nil
+}
+
+// Returns the RRULE value of the recurrence, for example FREQ=MONTHLY;INTERVAL=2;BYDAY=-1FR.
+//
+// # Remarks
+//
+// Start, RDates, and ExDates are not part of the RRULE value.
+func (recurrence Recurrence) String() string {
+	parts := []string{"FREQ=" + recurrence.Frequency.String()}
+	if recurrence.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(recurrence.Interval))
+	}
+	if recurrence.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(recurrence.Count))
+	}
+	if !recurrence.Until.IsZero() {
+		parts = append(parts, "UNTIL="+recurrence.Until.Format("20060102"))
+	}
+	if len(recurrence.ByMonth) > 0 {
+		months := make([]int, len(recurrence.ByMonth))
+		for i, month := range recurrence.ByMonth {
+			months[i] = int(month)
+		}
+		parts = append(parts, "BYMONTH="+joinInts(months))
+	}
+	if len(recurrence.ByMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(recurrence.ByMonthDay))
+	}
+	if len(recurrence.ByDay) > 0 {
+		days := make([]string, len(recurrence.ByDay))
+		for i, day := range recurrence.ByDay {
+			days[i] = day.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(recurrence.BySetPos) > 0 {
+		parts = append(parts, "BYSETPOS="+joinInts(recurrence.BySetPos))
+	}
+	if recurrence.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayCodes[recurrence.WeekStart])
+	}
+	return strings.Join(parts, ";")
+}
+
+func joinInts(values []int) string {
+	items := make([]string, len(values))
+	for i, value := range values {
+		items[i] = strconv.Itoa(value)
+	}
+	return strings.Join(items, ",")
+}
+
+// Returns an iterator over the occurrences of the recurrence in ascending order.
+//
+// # Returns
+//
+//	seq iter.Seq[Date]
+//
+// The iterator over the occurrences; it is infinite if the rule has neither Count nor Until.
+func (recurrence Recurrence) All() (seq iter.Seq[Date]) {
+	return func(yield func(Date) bool) {
+		excluded := make(map[Date]bool, len(recurrence.ExDates))
+		for _, date := range recurrence.ExDates {
+			excluded[date] = true
+		}
+		extra := slices.Clone(recurrence.RDates)
+		slices.SortFunc(extra, Date.Compare)
+		last := Date{days: -1}
+		emit := func(date Date) bool {
+			if date.After(last) && !excluded[date] {
+				last = date
+				return yield(date)
+			}
+			return true
+		}
+		for date := range recurrence.rule() {
+			for len(extra) > 0 && extra[0].Before(date) {
+				if !emit(extra[0]) {
+					return
+				}
+				extra = extra[1:]
+			}
+			if !emit(date) {
+				return
+			}
+		}
+		for _, date := range extra {
+			if !emit(date) {
+				return
+			}
+		}
+	}
+}
+
+// Returns the occurrences between from and to, both inclusive.
+//
+// # Parameters
+//
+//	from Date
+//
+// The first date of the range.
+//
+//	to Date
+//
+// The last date of the range.
+//
+// # Returns
+//
+//	dates []Date
+//
+// The occurrences in ascending order.
+func (recurrence Recurrence) Between(from Date, to Date) (dates []Date) {
+	for date := range recurrence.All() {
+		if date.After(to) {
+			break
+		}
+		if !date.Before(from) {
+			dates = append(dates, date)
+		}
+	}
+	return dates
+}
+
+// Returns the first occurrence after date.
+//
+// # Parameters
+//
+//	date Date
+//
+// The date after which to search.
+//
+// # Returns
+//
+//	next Date
+//
+// The first occurrence after date.
+//
+//	ok bool
+//
+// False if there is no occurrence after date, true otherwise.
+func (recurrence Recurrence) After(date Date) (next Date, ok bool) {
+	for occurrence := range recurrence.All() {
+		if occurrence.After(date) {
+			return occurrence, true
+		}
+	}
+	return Date{}, false
+}
+
+// The last date for which occurrences are generated.
+var maxRecurrenceDate = New(9999, time.December, 31)
+
+// Returns an iterator over the occurrences of the RRULE, starting with Start and bounded by Count and Until.
+func (recurrence Recurrence) rule() iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		until := maxRecurrenceDate
+		if !recurrence.Until.IsZero() && recurrence.Until.Before(until) {
+			until = recurrence.Until
+		}
+		interval := max(recurrence.Interval, 1)
+		count := 0
+		emit := func(date Date) bool {
+			count++
+			return yield(date) && (recurrence.Count == 0 || count < recurrence.Count)
+		}
+		if recurrence.Start.After(until) || !emit(recurrence.Start) {
+			return
+		}
+		for period := 0; ; period += interval {
+			dates, first := recurrence.period(period)
+			if first.After(until) {
+				return
+			}
+			for _, date := range dates {
+				if !date.After(recurrence.Start) {
+					continue
+				}
+				if date.After(until) || !emit(date) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Returns the sorted candidate dates of the n-th frequency period after the one containing Start, and the first day of that period.
+func (recurrence Recurrence) period(n int) (dates []Date, first Date) {
+	start := recurrence.Start
+	year, month, day := start.Deconstruct()
+	switch recurrence.Frequency {
+	case Daily:
+		date := start.AddDays(n)
+		if recurrence.matchesMonth(date) && recurrence.matchesMonthDay(date) && recurrence.matchesWeekday(date, false) {
+			dates = []Date{date}
+		}
+		return recurrence.setPos(dates), date
+	case Weekly:
+		first = start.AddDays(7*n - (int(start.Weekday()-recurrence.WeekStart)+7)%7)
+		for i := range 7 {
+			date := first.AddDays(i)
+			if len(recurrence.ByDay) == 0 && date.Weekday() != start.Weekday() {
+				continue
+			}
+			if recurrence.matchesMonth(date) && recurrence.matchesWeekday(date, false) {
+				dates = append(dates, date)
+			}
+		}
+		return recurrence.setPos(dates), first
+	case Monthly:
+		first = New(year, month+time.Month(n), 1)
+		if recurrence.matchesMonth(first) {
+			dates = recurrence.monthDates(first, day)
+		}
+		return recurrence.setPos(dates), first
+	default:
+		first = New(year+n, time.January, 1)
+		months := recurrence.ByMonth
+		switch {
+		case len(months) > 0 || len(recurrence.ByMonthDay) > 0:
+			if len(months) == 0 {
+				months = []time.Month{time.January, time.February, time.March, time.April, time.May, time.June,
+					time.July, time.August, time.September, time.October, time.November, time.December}
+			}
+			for _, m := range months {
+				dates = append(dates, recurrence.monthDates(New(year+n, m, 1), day)...)
+			}
+		case len(recurrence.ByDay) > 0:
+			last := New(year+n, time.December, 31)
+			for _, byDay := range recurrence.ByDay {
+				dates = append(dates, weekdaysBetween(first, last, byDay)...)
+			}
+		default:
+			if date := New(year+n, month, day); date.Day() == day {
+				dates = []Date{date}
+			}
+		}
+		slices.SortFunc(dates, Date.Compare)
+		return recurrence.setPos(slices.Compact(dates)), first
+	}
+}
+
+// Returns the sorted candidate dates within the month starting on first, using day when neither BYMONTHDAY nor BYDAY is set.
+func (recurrence Recurrence) monthDates(first Date, day int) (dates []Date) {
+	last := first.AddMonths(1).AddDays(-1)
+	switch {
+	case len(recurrence.ByMonthDay) > 0:
+		for _, monthDay := range recurrence.ByMonthDay {
+			date := first.AddDays(monthDay - 1)
+			if monthDay < 0 {
+				date = last.AddDays(monthDay + 1)
+			}
+			if date.Month() == first.Month() && recurrence.matchesWeekday(date, true) {
+				dates = append(dates, date)
+			}
+		}
+	case len(recurrence.ByDay) > 0:
+		for _, byDay := range recurrence.ByDay {
+			dates = append(dates, weekdaysBetween(first, last, byDay)...)
+		}
+	default:
+		if date := first.AddDays(day - 1); date.Month() == first.Month() {
+			dates = []Date{date}
+		}
+	}
+	slices.SortFunc(dates, Date.Compare)
+	return slices.Compact(dates)
+}
+
+// Returns the occurrences of day.Weekday between first and last, or only its day.N-th occurrence if day.N is not 0.
+func weekdaysBetween(first Date, last Date, day RecurrenceDay) (dates []Date) {
+	if day.N < 0 {
+		date := last.AddDays(-(int(last.Weekday()-day.Weekday)+7)%7 + 7*(day.N+1))
+		if !date.Before(first) {
+			return []Date{date}
+		}
+		return nil
+	}
+	date := first.AddDays((int(day.Weekday-first.Weekday()) + 7) % 7)
+	if day.N > 0 {
+		date = date.AddDays(7 * (day.N - 1))
+		if !date.After(last) {
+			return []Date{date}
+		}
+		return nil
+	}
+	for ; !date.After(last); date = date.AddDays(7) {
+		dates = append(dates, date)
+	}
+	return dates
+}
+
+// Reports whether date is in one of the months of BYMONTH, if set.
+func (recurrence Recurrence) matchesMonth(date Date) bool {
+	return len(recurrence.ByMonth) == 0 || slices.Contains(recurrence.ByMonth, date.Month())
+}
+
+// Reports whether date is one of the days of BYMONTHDAY, if set.
+func (recurrence Recurrence) matchesMonthDay(date Date) bool {
+	if len(recurrence.ByMonthDay) == 0 {
+		return true
+	}
+	day := date.Day()
+	daysInMonth := date.DaysInMonth()
+	return slices.ContainsFunc(recurrence.ByMonthDay, func(monthDay int) bool {
+		return monthDay == day || monthDay == day-daysInMonth-1
+	})
+}
+
+// Reports whether date is one of the days of BYDAY, if set. If withinMonth is true, the ordinals are checked within the month of date.
+func (recurrence Recurrence) matchesWeekday(date Date, withinMonth bool) bool {
+	if len(recurrence.ByDay) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(recurrence.ByDay, func(day RecurrenceDay) bool {
+		if day.Weekday != date.Weekday() {
+			return false
+		}
+		if day.N == 0 || !withinMonth {
+			return true
+		}
+		nth, ok := NthWeekdayOfMonth(date.Year(), date.Month(), day.Weekday, day.N)
+		return ok && nth == date
+	})
+}
+
+// Applies BYSETPOS to the sorted dates of a period.
+func (recurrence Recurrence) setPos(dates []Date) []Date {
+	if len(recurrence.BySetPos) == 0 || len(dates) == 0 {
+		return dates
+	}
+	var selected []Date
+	for _, position := range recurrence.BySetPos {
+		i := position - 1
+		if position < 0 {
+			i = len(dates) + position
+		}
+		if i >= 0 && i < len(dates) {
+			selected = append(selected, dates[i])
+		}
+	}
+	slices.SortFunc(selected, Date.Compare)
+	return slices.Compact(selected)
+}
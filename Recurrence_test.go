@@ -0,0 +1,201 @@
+package date
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func dates(values ...string) []Date {
+	result := make([]Date, len(values))
+	for i, value := range values {
+		date, err := Parse(time.DateOnly, value)
+		if err != nil {
+			panic(err)
+		}
+		result[i] = date
+	}
+	return result
+}
+
+func TestRecurrence_All(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		limit int
+		want  []Date
+	}{
+		{
+			name:  "Daily count",
+			value: "DTSTART;VALUE=DATE:19970902\nRRULE:FREQ=DAILY;COUNT=4",
+			want:  dates("1997-09-02", "1997-09-03", "1997-09-04", "1997-09-05"),
+		},
+		{
+			name:  "Every other day until",
+			value: "DTSTART:19970902\nRRULE:FREQ=DAILY;INTERVAL=2;UNTIL=19970908T000000Z",
+			want:  dates("1997-09-02", "1997-09-04", "1997-09-06", "1997-09-08"),
+		},
+		{
+			name:  "Weekly on Tuesday and Thursday",
+			value: "DTSTART:19970902\nRRULE:FREQ=WEEKLY;COUNT=6;BYDAY=TU,TH",
+			want:  dates("1997-09-02", "1997-09-04", "1997-09-09", "1997-09-11", "1997-09-16", "1997-09-18"),
+		},
+		{
+			name:  "Every other week - week start Monday",
+			value: "DTSTART:19970805\nRRULE:FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=MO",
+			want:  dates("1997-08-05", "1997-08-10", "1997-08-19", "1997-08-24"),
+		},
+		{
+			name:  "Every other week - week start Sunday",
+			value: "DTSTART:19970805\nRRULE:FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=SU",
+			want:  dates("1997-08-05", "1997-08-17", "1997-08-19", "1997-08-31"),
+		},
+		{
+			name:  "Monthly on the last Friday",
+			value: "DTSTART:20240126\nRRULE:FREQ=MONTHLY;COUNT=3;BYDAY=-1FR",
+			want:  dates("2024-01-26", "2024-02-23", "2024-03-29"),
+		},
+		{
+			name:  "Monthly on the 31st skips short months",
+			value: "DTSTART:20240131\nRRULE:FREQ=MONTHLY;COUNT=4",
+			want:  dates("2024-01-31", "2024-03-31", "2024-05-31", "2024-07-31"),
+		},
+		{
+			name:  "Monthly on the second-to-last day",
+			value: "DTSTART:20240130\nRRULE:FREQ=MONTHLY;COUNT=3;BYMONTHDAY=-2",
+			want:  dates("2024-01-30", "2024-02-28", "2024-03-30"),
+		},
+		{
+			name:  "Last business day of the month",
+			value: "DTSTART:20240131\nRRULE:FREQ=MONTHLY;COUNT=4;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			want:  dates("2024-01-31", "2024-02-29", "2024-03-29", "2024-04-30"),
+		},
+		{
+			name:  "Friday the 13th",
+			value: "DTSTART:20240913\nRRULE:FREQ=MONTHLY;COUNT=3;BYDAY=FR;BYMONTHDAY=13",
+			want:  dates("2024-09-13", "2024-12-13", "2025-06-13"),
+		},
+		{
+			name:  "Yearly in June and July",
+			value: "DTSTART:19970610\nRRULE:FREQ=YEARLY;COUNT=4;BYMONTH=6,7",
+			want:  dates("1997-06-10", "1997-07-10", "1998-06-10", "1998-07-10"),
+		},
+		{
+			name:  "Yearly on the fourth Thursday of November",
+			value: "DTSTART:20241128\nRRULE:FREQ=YEARLY;COUNT=3;BYMONTH=11;BYDAY=4TH",
+			want:  dates("2024-11-28", "2025-11-27", "2026-11-26"),
+		},
+		{
+			name:  "Yearly on the 20th Monday",
+			value: "DTSTART:19970519\nRRULE:FREQ=YEARLY;COUNT=3;BYDAY=20MO",
+			want:  dates("1997-05-19", "1998-05-18", "1999-05-17"),
+		},
+		{
+			name:  "Yearly on February 29",
+			value: "DTSTART:20240229\nRRULE:FREQ=YEARLY;COUNT=3",
+			want:  dates("2024-02-29", "2028-02-29", "2032-02-29"),
+		},
+		{
+			name:  "Start not matching the rule",
+			value: "DTSTART:20240102\nRRULE:FREQ=MONTHLY;COUNT=3;BYMONTHDAY=15",
+			want:  dates("2024-01-02", "2024-01-15", "2024-02-15"),
+		},
+		{
+			name:  "Excluded and additional dates",
+			value: "DTSTART:20240101\nRRULE:FREQ=MONTHLY;COUNT=4\nEXDATE;VALUE=DATE:20240201,20240101\nRDATE;VALUE=DATE:20240115,20240301,20241225",
+			want:  dates("2024-01-15", "2024-03-01", "2024-04-01", "2024-12-25"),
+		},
+		{
+			name:  "Unbounded",
+			value: "DTSTART:20240101\nRRULE:FREQ=YEARLY;INTERVAL=10",
+			limit: 3,
+			want:  dates("2024-01-01", "2034-01-01", "2044-01-01"),
+		},
+		{
+			name:  "Never matching",
+			value: "DTSTART:99990101\nRRULE:FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30",
+			want:  dates("9999-01-01"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recurrence, err := ParseRecurrence(tt.value)
+			if err != nil {
+				t.Fatalf("ParseRecurrence() error = %v", err)
+			}
+			var got []Date
+			for date := range recurrence.All() {
+				got = append(got, date)
+				if len(got) == tt.limit {
+					break
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Recurrence.All() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrence_BetweenAfter(t *testing.T) {
+	recurrence, err := ParseRecurrence("FREQ=MONTHLY;BYDAY=-1FR")
+	if err != nil {
+		t.Fatalf("ParseRecurrence() error = %v", err)
+	}
+	recurrence.Start = New(2024, time.January, 26)
+	want := dates("2024-03-29", "2024-04-26", "2024-05-31")
+	if got := recurrence.Between(New(2024, time.March, 29), New(2024, time.May, 31)); !slices.Equal(got, want) {
+		t.Errorf("Recurrence.Between() = %v, want %v", got, want)
+	}
+	if got, ok := recurrence.After(New(2024, time.March, 29)); got != New(2024, time.April, 26) || !ok {
+		t.Errorf("Recurrence.After() = %v, %v, want 2024-04-26, true", got, ok)
+	}
+	recurrence.Count = 2
+	if got, ok := recurrence.After(New(2024, time.March, 1)); ok {
+		t.Errorf("Recurrence.After() = %v, %v, want false", got, ok)
+	}
+}
+
+func TestParseRecurrence_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr string
+	}{
+		{name: "Missing frequency", value: "COUNT=3", wantErr: "missing FREQ"},
+		{name: "Time frequency", value: "FREQ=HOURLY", wantErr: "does not apply to dates"},
+		{name: "Time rule part", value: "FREQ=DAILY;BYHOUR=9", wantErr: "does not apply to dates"},
+		{name: "Count and until", value: "FREQ=DAILY;COUNT=3;UNTIL=20240101", wantErr: "COUNT and UNTIL"},
+		{name: "Ordinal in weekly rule", value: "FREQ=WEEKLY;BYDAY=1MO", wantErr: "ordinals"},
+		{name: "Invalid month day", value: "FREQ=MONTHLY;BYMONTHDAY=0", wantErr: "BYMONTHDAY"},
+		{name: "Invalid weekday", value: "FREQ=MONTHLY;BYDAY=XX", wantErr: "BYDAY"},
+		{name: "Unsupported part", value: "FREQ=YEARLY;BYWEEKNO=20", wantErr: "BYWEEKNO"},
+		{name: "Invalid start", value: "DTSTART:2024-01-01\nRRULE:FREQ=DAILY", wantErr: "DTSTART"},
+		{name: "Missing rule", value: "DTSTART:20240101", wantErr: "missing RRULE"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseRecurrence(tt.value)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseRecurrence() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRecurrence_String(t *testing.T) {
+	for _, value := range []string{
+		"FREQ=MONTHLY;INTERVAL=2;COUNT=10;BYDAY=1SU,-1SU",
+		"FREQ=YEARLY;UNTIL=20301231;BYMONTH=1,7;BYMONTHDAY=-1",
+		"FREQ=WEEKLY;BYDAY=MO,WE;BYSETPOS=1;WKST=SU",
+	} {
+		recurrence, err := ParseRecurrence("RRULE:" + value)
+		if err != nil {
+			t.Fatalf("ParseRecurrence(%q) error = %v", value, err)
+		}
+		if got := recurrence.String(); got != value {
+			t.Errorf("Recurrence.String() = %q, want %q", got, value)
+		}
+	}
+}
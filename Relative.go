@@ -0,0 +1,509 @@
+package date
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Represents the vocabulary of a language for ParseRelative.
+//
+// # Remarks
+//
+// Phrases may have several words, such as start of; they are matched against the lower-case words of an expression.
+// The structure of the expressions is fixed, so a grammar for another language is written by supplying its words,
+// with every inflected form as a separate key where the language needs it, as in the Polish dzień, dni.
+type RelativeGrammar struct {
+	// Phrases for the base date, the day before, and the day after, for example today, yesterday, and tomorrow.
+	Today     []string
+	Yesterday []string
+	Tomorrow  []string
+	// Modifiers selecting the following, the preceding, or the current weekday or period, for example next, last, and this.
+	Next []string
+	Last []string
+	This []string
+	// Phrases before or after a count of units moving forward or backward, for example in and ago.
+	In  []string
+	Ago []string
+	// Phrases before a period selecting its first or its last day, for example start of and end of.
+	StartOf []string
+	EndOf   []string
+	// Optional phrases between an ordinal and a period, for example of.
+	Of []string
+	// Words that are skipped, for example the.
+	Ignored []string
+	// Suffixes of numeric ordinals, for example th in 15th.
+	OrdinalSuffixes []string
+	// Names of units, in every form, for example day and days.
+	Units map[string]Unit
+	// Names of weekdays, in every form, for example friday and fri.
+	Weekdays map[string]time.Weekday
+	// Names of months, in every form, for example march and mar.
+	Months map[string]time.Month
+	// Ordinal words, with -1 for the last one, for example first and last.
+	Ordinals map[string]int
+	// Number words, for example a and three.
+	Numbers map[string]int
+	// The first day of week periods, as in end of next week.
+	WeekStart time.Weekday
+}
+
+// The English grammar of ParseRelative, with weeks starting on Monday.
+var EnglishGrammar = &RelativeGrammar{
+	Today:           []string{"today", "now"},
+	Yesterday:       []string{"yesterday"},
+	Tomorrow:        []string{"tomorrow"},
+	Next:            []string{"next", "following"},
+	Last:            []string{"last", "previous", "past"},
+	This:            []string{"this", "current", "coming"},
+	In:              []string{"in", "from now", "later"},
+	Ago:             []string{"ago", "before", "earlier"},
+	StartOf:         []string{"start of", "beginning of"},
+	EndOf:           []string{"end of"},
+	Of:              []string{"of", "in"},
+	Ignored:         []string{"the", "on"},
+	OrdinalSuffixes: []string{"st", "nd", "rd", "th"},
+	Units: map[string]Unit{
+		"day": UnitDay, "days": UnitDay,
+		"week": UnitWeek, "weeks": UnitWeek,
+		"month": UnitMonth, "months": UnitMonth,
+		"quarter": UnitQuarter, "quarters": UnitQuarter,
+		"year": UnitYear, "years": UnitYear,
+	},
+	Weekdays: map[string]time.Weekday{
+		"sunday": time.Sunday, "sun": time.Sunday,
+		"monday": time.Monday, "mon": time.Monday,
+		"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+		"wednesday": time.Wednesday, "wed": time.Wednesday,
+		"thursday": time.Thursday, "thu": time.Thursday, "thurs": time.Thursday,
+		"friday": time.Friday, "fri": time.Friday,
+		"saturday": time.Saturday, "sat": time.Saturday,
+	},
+	Months: map[string]time.Month{
+		"january": time.January, "jan": time.January,
+		"february": time.February, "feb": time.February,
+		"march": time.March, "mar": time.March,
+		"april": time.April, "apr": time.April,
+		"may":  time.May,
+		"june": time.June, "jun": time.June,
+		"july": time.July, "jul": time.July,
+		"august": time.August, "aug": time.August,
+		"september": time.September, "sep": time.September, "sept": time.September,
+		"october": time.October, "oct": time.October,
+		"november": time.November, "nov": time.November,
+		"december": time.December, "dec": time.December,
+	},
+	Ordinals: map[string]int{"first": 1, "second": 2, "third": 3, "fourth": 4, "fifth": 5, "last": -1},
+	Numbers: map[string]int{
+		"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6,
+		"seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12,
+	},
+	WeekStart: time.Monday,
+}
+
+// Parses an English date expression relative to a base date, such as next friday or last day of next month.
+//
+// # Parameters
+//
+//	expression string
+//
+// The expression. Case and extra white space are ignored.
+//
+//	base Date
+//
+// The date the expression is relative to, usually Today().
+//
+// # Returns
+//
+//	date Date
+//
+// The date of the expression.
+//
+//	err error
+//
+// An error if expression is not recognized or names a day that does not exist, such as fifth monday of february 2026.
+//
+// # Remarks
+//
+// It is shorthand for EnglishGrammar.Parse(expression, base); see [RelativeGrammar.Parse] for the recognized expressions.
+func ParseRelative(expression string, base Date) (date Date, err error) {
+	return EnglishGrammar.Parse(expression, base)
+}
+
+// Parses a date expression relative to a base date.
+//
+// # Parameters
+//
+//	expression string
+//
+// The expression. Case and extra white space are ignored.
+//
+//	base Date
+//
+// The date the expression is relative to, usually Today().
+//
+// # Returns
+//
+//	date Date
+//
+// The date of the expression.
+//
+//	err error
+//
+// An error if expression is not recognized or names a day that does not exist.
+//
+// # Remarks
+//
+// The recognized expressions, shown with the words of EnglishGrammar, are:
+//   - today, yesterday, tomorrow;
+//   - <weekday>, this <weekday>: the weekday on or after base; next <weekday>: after base; last <weekday>: before base;
+//   - next <unit>, last <unit>: base moved by one unit;
+//   - in <n> <unit>, <n> <unit> from now: base moved forward; <n> <unit> ago: base moved backward;
+//   - start of <period>, end of <period>: the first or last day of the period;
+//   - <ordinal> <weekday> of <period>, as in first monday of march or last friday of next month;
+//   - <ordinal> day of <period>, <ordinal> of <period>, <month> <ordinal>, as in the 15th of next month or march 15;
+//   - the <ordinal>, as in the 15th: the next such day of a month on or after base.
+//
+// A period is a unit preceded by this, next, or last, or alone for this unit, or a month name optionally followed by a year,
+// which is the month in the year of base if the year is omitted. Ordinals are words such as first and last or numbers with an optional suffix.
+// The of phrase is optional, as in 15th march or the Polish pierwszy poniedziałek marca.
+// Moving by months, quarters, or years keeps the day of the month, or takes the last day of a shorter month.
+// An expression that is not recognized is parsed by ParseAny, so dates such as 2024-05-15 are also accepted.
+func (grammar *RelativeGrammar) Parse(expression string, base Date) (date Date, err error) {
+	var words []string
+	for _, word := range strings.Fields(strings.ToLower(strings.ReplaceAll(expression, ",", " "))) {
+		if !slices.Contains(grammar.Ignored, word) {
+			words = append(words, word)
+		}
+	}
+	parser := relativeParser{grammar: grammar, words: words, base: base}
+	for _, alternative := range []func(*relativeParser) (Date, bool){
+		(*relativeParser).day,
+		(*relativeParser).count,
+		(*relativeParser).boundary,
+		(*relativeParser).ordinalOf,
+		(*relativeParser).monthOrdinal,
+		(*relativeParser).dayOfMonth,
+		(*relativeParser).weekday,
+		(*relativeParser).shift,
+	} {
+		parser.position = 0
+		if date, ok := alternative(&parser); ok && parser.position == len(words) {
+			return date, nil
+		}
+	}
+	if parser.err != nil {
+		return Date{}, parser.err
+	}
+	if date, err := ParseAny(expression, ParseOptions{}); err == nil {
+		return date, nil
+	}
+	return Date{}, fmt.Errorf("date.RelativeGrammar.Parse: unrecognized expression %q", expression)
+}
+
+type relativeParser struct {
+	grammar  *RelativeGrammar
+	words    []string
+	position int
+	base     Date
+	// The error of an expression that is recognized but names a day that does not exist.
+	err error
+}
+
+// Consumes the longest of phrases at the current position.
+func (parser *relativeParser) phrase(phrases []string) bool {
+	length := 0
+	for _, phrase := range phrases {
+		fields := strings.Fields(phrase)
+		if len(fields) > length && parser.position+len(fields) <= len(parser.words) && slices.Equal(parser.words[parser.position:parser.position+len(fields)], fields) {
+			length = len(fields)
+		}
+	}
+	parser.position += length
+	return length > 0
+}
+
+// Consumes a word that is a key of values.
+func lookupWord[V any](parser *relativeParser, values map[string]V) (value V, ok bool) {
+	if parser.position < len(parser.words) {
+		if value, ok = values[parser.words[parser.position]]; ok {
+			parser.position++
+		}
+	}
+	return value, ok
+}
+
+// Consumes a number, in digits or a number word.
+func (parser *relativeParser) number() (n int, ok bool) {
+	if n, ok = lookupWord(parser, parser.grammar.Numbers); ok {
+		return n, true
+	}
+	if parser.position < len(parser.words) {
+		if n, err := parseDigits(parser.words[parser.position]); err == nil {
+			parser.position++
+			return n, true
+		}
+	}
+	return 0, false
+}
+
+// Consumes an ordinal, a word or a number with an optional suffix; -1 is the last one.
+func (parser *relativeParser) ordinal() (n int, ok bool) {
+	if n, ok = lookupWord(parser, parser.grammar.Ordinals); ok {
+		return n, true
+	}
+	if parser.position < len(parser.words) {
+		word := parser.words[parser.position]
+		for _, suffix := range parser.grammar.OrdinalSuffixes {
+			if trimmed, found := strings.CutSuffix(word, suffix); found && trimmed != "" {
+				word = trimmed
+				break
+			}
+		}
+		if n, err := parseDigits(word); err == nil && n > 0 {
+			parser.position++
+			return n, true
+		}
+	}
+	return 0, false
+}
+
+// Consumes a next, last, or this modifier and returns +1, -1, or 0.
+func (parser *relativeParser) modifier() (delta int, ok bool) {
+	switch {
+	case parser.phrase(parser.grammar.Next):
+		return +1, true
+	case parser.phrase(parser.grammar.Last):
+		return -1, true
+	case parser.phrase(parser.grammar.This):
+		return 0, true
+	default:
+		return 0, false
+	}
+}
+
+// Consumes a period and returns its first and last day.
+func (parser *relativeParser) period() (first Date, last Date, ok bool) {
+	start := parser.position
+	delta, _ := parser.modifier()
+	if unit, ok := lookupWord(parser, parser.grammar.Units); ok {
+		first, last = parser.unitPeriod(addUnits(parser.base, unit, delta), unit)
+		return first, last, true
+	}
+	parser.position = start
+	if yearMonth, ok := parser.month(); ok {
+		return yearMonth.First(), yearMonth.Last(), true
+	}
+	return Date{}, Date{}, false
+}
+
+// Consumes a month name optionally followed by a four-digit year, by default the year of base.
+func (parser *relativeParser) month() (yearMonth YearMonth, ok bool) {
+	month, ok := lookupWord(parser, parser.grammar.Months)
+	if !ok {
+		return YearMonth{}, false
+	}
+	year := parser.base.Year()
+	if parser.position < len(parser.words) && len(parser.words[parser.position]) == 4 {
+		if n, err := parseDigits(parser.words[parser.position]); err == nil {
+			year = n
+			parser.position++
+		}
+	}
+	return NewYearMonth(year, month), true
+}
+
+// Returns the first and last day of the unit containing date.
+func (parser *relativeParser) unitPeriod(date Date, unit Unit) (first Date, last Date) {
+	if unit == UnitWeek {
+		return date.StartOfWeek(parser.grammar.WeekStart), date.EndOfWeek(parser.grammar.WeekStart)
+	}
+	first = date.Truncate(unit)
+	return first, first.AddPeriod(unit.Period()).AddDays(-1)
+}
+
+// Parses today, yesterday, and tomorrow.
+func (parser *relativeParser) day() (date Date, ok bool) {
+	switch {
+	case parser.phrase(parser.grammar.Today):
+		return parser.base, true
+	case parser.phrase(parser.grammar.Yesterday):
+		return parser.base.AddDays(-1), true
+	case parser.phrase(parser.grammar.Tomorrow):
+		return parser.base.AddDays(1), true
+	default:
+		return Date{}, false
+	}
+}
+
+// Parses in <n> <unit>, <n> <unit> from now, and <n> <unit> ago, with the phrases before or after the count.
+func (parser *relativeParser) count() (date Date, ok bool) {
+	sign := 0
+	switch {
+	case parser.phrase(parser.grammar.In):
+		sign = +1
+	case parser.phrase(parser.grammar.Ago):
+		sign = -1
+	}
+	n, ok := parser.number()
+	if !ok {
+		return Date{}, false
+	}
+	unit, ok := lookupWord(parser, parser.grammar.Units)
+	if !ok {
+		return Date{}, false
+	}
+	if sign == 0 {
+		switch {
+		case parser.phrase(parser.grammar.In):
+			sign = +1
+		case parser.phrase(parser.grammar.Ago):
+			sign = -1
+		default:
+			return Date{}, false
+		}
+	}
+	return addUnits(parser.base, unit, sign*n), true
+}
+
+// Parses start of <period> and end of <period>.
+func (parser *relativeParser) boundary() (date Date, ok bool) {
+	end := false
+	switch {
+	case parser.phrase(parser.grammar.StartOf):
+	case parser.phrase(parser.grammar.EndOf):
+		end = true
+	default:
+		return Date{}, false
+	}
+	first, last, ok := parser.period()
+	if end {
+		return last, ok
+	}
+	return first, ok
+}
+
+// Parses <ordinal> <weekday> of <period>, <ordinal> day of <period>, and <ordinal> of <period>, where of is optional.
+func (parser *relativeParser) ordinalOf() (date Date, ok bool) {
+	n, ok := parser.ordinal()
+	if !ok {
+		return Date{}, false
+	}
+	weekday, isWeekday := lookupWord(parser, parser.grammar.Weekdays)
+	if !isWeekday {
+		if unit, ok := lookupWord(parser, parser.grammar.Units); ok && unit != UnitDay {
+			return Date{}, false
+		}
+	}
+	parser.phrase(parser.grammar.Of)
+	first, last, ok := parser.period()
+	if !ok || parser.position != len(parser.words) {
+		return Date{}, false
+	}
+	switch {
+	case isWeekday && n > 0:
+		date = first.AddDays(int(weekday-first.Weekday()+7)%7 + 7*(n-1))
+	case isWeekday:
+		date = last.AddDays(-int(last.Weekday()-weekday+7) % 7)
+	case n > 0:
+		date = first.AddDays(n - 1)
+	default:
+		date = last
+	}
+	if date.After(last) {
+		parser.err = fmt.Errorf("date.RelativeGrammar.Parse: %q does not exist", strings.Join(parser.words, " "))
+		return Date{}, false
+	}
+	return date, true
+}
+
+// Parses <month> <ordinal> [<year>], as in march 15 or march 15 2027.
+func (parser *relativeParser) monthOrdinal() (date Date, ok bool) {
+	month, ok := lookupWord(parser, parser.grammar.Months)
+	if !ok {
+		return Date{}, false
+	}
+	n, ok := parser.ordinal()
+	if !ok || n < 0 {
+		return Date{}, false
+	}
+	year := parser.base.Year()
+	if parser.position < len(parser.words) && len(parser.words[parser.position]) == 4 {
+		if n, err := parseDigits(parser.words[parser.position]); err == nil {
+			year = n
+			parser.position++
+		}
+	}
+	return parser.dayOf(NewYearMonth(year, month), n)
+}
+
+// Returns day n of yearMonth, recording an error if it does not exist.
+func (parser *relativeParser) dayOf(yearMonth YearMonth, n int) (date Date, ok bool) {
+	if n > yearMonth.DaysIn() {
+		parser.err = fmt.Errorf("date.RelativeGrammar.Parse: %q does not exist", strings.Join(parser.words, " "))
+		return Date{}, false
+	}
+	return yearMonth.First().AddDays(n - 1), true
+}
+
+// Parses <ordinal>, as in the 15th, as the next such day of a month on or after base.
+func (parser *relativeParser) dayOfMonth() (date Date, ok bool) {
+	if parser.position < len(parser.words) {
+		if _, isWord := parser.grammar.Ordinals[parser.words[parser.position]]; isWord {
+			return Date{}, false
+		}
+	}
+	n, ok := parser.ordinal()
+	if !ok || n > 31 {
+		return Date{}, false
+	}
+	for yearMonth := parser.base.YearMonth(); ; yearMonth = yearMonth.AddMonths(1) {
+		if date = yearMonth.First().AddDays(n - 1); n <= yearMonth.DaysIn() && !date.Before(parser.base) {
+			return date, true
+		}
+	}
+}
+
+// Parses <weekday>, this <weekday>, next <weekday>, and last <weekday>.
+func (parser *relativeParser) weekday() (date Date, ok bool) {
+	delta, hasModifier := parser.modifier()
+	weekday, ok := lookupWord(parser, parser.grammar.Weekdays)
+	if !ok {
+		return Date{}, false
+	}
+	days := int(weekday-parser.base.Weekday()+7) % 7
+	switch {
+	case hasModifier && delta > 0 && days == 0:
+		days = 7
+	case hasModifier && delta < 0:
+		days -= 7
+	}
+	return parser.base.AddDays(days), true
+}
+
+// Parses next <unit> and last <unit>.
+func (parser *relativeParser) shift() (date Date, ok bool) {
+	delta, ok := parser.modifier()
+	if !ok {
+		return Date{}, false
+	}
+	unit, ok := lookupWord(parser, parser.grammar.Units)
+	if !ok {
+		return Date{}, false
+	}
+	return addUnits(parser.base, unit, delta), true
+}
+
+// Returns date moved by n units, keeping the day of the month or taking the last day of a shorter month.
+func addUnits(date Date, unit Unit, n int) Date {
+	switch unit {
+	case UnitDay:
+		return date.AddDays(n)
+	case UnitWeek, UnitSundayWeek:
+		return date.AddDays(7 * n)
+	}
+	period := unit.Period()
+	yearMonth := date.YearMonth().AddMonths(n * (12*period.Years + period.Months))
+	return yearMonth.First().AddDays(min(date.Day(), yearMonth.DaysIn()) - 1)
+}
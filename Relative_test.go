@@ -0,0 +1,115 @@
+package date
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRelative(t *testing.T) {
+	wednesday := New(2026, time.October, 14)
+	tests := []struct {
+		expression string
+		base       Date
+		want       Date
+		wantErr    bool
+	}{
+		{expression: "today", base: wednesday, want: wednesday},
+		{expression: "Yesterday", base: wednesday, want: New(2026, time.October, 13)},
+		{expression: " tomorrow ", base: wednesday, want: New(2026, time.October, 15)},
+		{expression: "friday", base: wednesday, want: New(2026, time.October, 16)},
+		{expression: "next friday", base: wednesday, want: New(2026, time.October, 16)},
+		{expression: "this wednesday", base: wednesday, want: wednesday},
+		{expression: "next wednesday", base: wednesday, want: New(2026, time.October, 21)},
+		{expression: "last wednesday", base: wednesday, want: New(2026, time.October, 7)},
+		{expression: "last fri", base: wednesday, want: New(2026, time.October, 9)},
+		{expression: "on monday", base: wednesday, want: New(2026, time.October, 19)},
+		{expression: "in 3 days", base: wednesday, want: New(2026, time.October, 17)},
+		{expression: "in 2 weeks", base: wednesday, want: New(2026, time.October, 28)},
+		{expression: "in 4 months", base: wednesday, want: New(2027, time.February, 14)},
+		{expression: "in a month", base: New(2026, time.January, 31), want: New(2026, time.February, 28)},
+		{expression: "two years from now", base: New(2024, time.February, 29), want: New(2026, time.February, 28)},
+		{expression: "3 days ago", base: wednesday, want: New(2026, time.October, 11)},
+		{expression: "a week ago", base: wednesday, want: New(2026, time.October, 7)},
+		{expression: "next week", base: wednesday, want: New(2026, time.October, 21)},
+		{expression: "last month", base: wednesday, want: New(2026, time.September, 14)},
+		{expression: "end of month", base: wednesday, want: New(2026, time.October, 31)},
+		{expression: "end of next month", base: wednesday, want: New(2026, time.November, 30)},
+		{expression: "start of next week", base: wednesday, want: New(2026, time.October, 19)},
+		{expression: "end of this week", base: wednesday, want: New(2026, time.October, 18)},
+		{expression: "end of the year", base: wednesday, want: New(2026, time.December, 31)},
+		{expression: "beginning of last quarter", base: wednesday, want: New(2026, time.July, 1)},
+		{expression: "end of february 2028", base: wednesday, want: New(2028, time.February, 29)},
+		{expression: "first monday of march", base: wednesday, want: New(2026, time.March, 2)},
+		{expression: "last friday of next month", base: wednesday, want: New(2026, time.November, 27)},
+		{expression: "second tuesday in november", base: wednesday, want: New(2026, time.November, 10)},
+		{expression: "last day of next month", base: wednesday, want: New(2026, time.November, 30)},
+		{expression: "first day of the quarter", base: wednesday, want: New(2026, time.October, 1)},
+		{expression: "the 15th of next month", base: wednesday, want: New(2026, time.November, 15)},
+		{expression: "15th march 2027", base: wednesday, want: New(2027, time.March, 15)},
+		{expression: "March 15", base: wednesday, want: New(2026, time.March, 15)},
+		{expression: "Dec 25, 2027", base: wednesday, want: New(2027, time.December, 25)},
+		{expression: "the 15th", base: wednesday, want: New(2026, time.October, 15)},
+		{expression: "the 14th", base: wednesday, want: wednesday},
+		{expression: "the 1st", base: wednesday, want: New(2026, time.November, 1)},
+		{expression: "the 31st", base: New(2026, time.November, 5), want: New(2026, time.December, 31)},
+		{expression: "2024-05-15", base: wednesday, want: New(2024, time.May, 15)},
+		{expression: "fifth monday of february", base: wednesday, wantErr: true},
+		{expression: "february 30", base: wednesday, wantErr: true},
+		{expression: "the 32nd", base: wednesday, wantErr: true},
+		{expression: "in days", base: wednesday, wantErr: true},
+		{expression: "3 days", base: wednesday, wantErr: true},
+		{expression: "someday", base: wednesday, wantErr: true},
+		{expression: "", base: wednesday, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			got, err := ParseRelative(tt.expression, tt.base)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseRelative() = %v, %v, want %v, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRelativeGrammar_Parse(t *testing.T) {
+	polish := &RelativeGrammar{
+		Today:     []string{"dziś", "dzisiaj"},
+		Tomorrow:  []string{"jutro"},
+		Next:      []string{"następny", "następnego", "przyszły", "przyszłego"},
+		Last:      []string{"ostatni", "zeszły", "zeszłego"},
+		In:        []string{"za"},
+		Ago:       []string{"temu"},
+		EndOf:     []string{"koniec"},
+		Units:     map[string]Unit{"dzień": UnitDay, "dni": UnitDay, "tygodnie": UnitWeek, "tygodnia": UnitWeek, "miesiąca": UnitMonth},
+		Weekdays:  map[string]time.Weekday{"poniedziałek": time.Monday, "piątek": time.Friday},
+		Months:    map[string]time.Month{"marca": time.March},
+		Ordinals:  map[string]int{"pierwszy": 1, "ostatni": -1},
+		WeekStart: time.Monday,
+	}
+	wednesday := New(2026, time.October, 14)
+	tests := []struct {
+		expression string
+		want       Date
+	}{
+		{expression: "Dzisiaj", want: wednesday},
+		{expression: "za 3 dni", want: New(2026, time.October, 17)},
+		{expression: "2 tygodnie temu", want: New(2026, time.September, 30)},
+		{expression: "następny piątek", want: New(2026, time.October, 16)},
+		{expression: "koniec przyszłego miesiąca", want: New(2026, time.November, 30)},
+		{expression: "koniec tygodnia", want: New(2026, time.October, 18)},
+		{expression: "pierwszy poniedziałek marca", want: New(2026, time.March, 2)},
+		{expression: "ostatni dzień zeszłego miesiąca", want: New(2026, time.September, 30)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			if got, err := polish.Parse(tt.expression, wednesday); err != nil || got != tt.want {
+				t.Errorf("RelativeGrammar.Parse() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+	sundayStart := *EnglishGrammar
+	sundayStart.WeekStart = time.Sunday
+	if got, err := sundayStart.Parse("end of this week", wednesday); err != nil || got != New(2026, time.October, 17) {
+		t.Errorf("RelativeGrammar.Parse() = %v, %v, want 2026-10-17", got, err)
+	}
+}
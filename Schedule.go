@@ -0,0 +1,253 @@
+package date
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Specifies where the irregular period of a schedule is placed and whether it is shorter or longer than a regular period.
+//
+// # Remarks
+//
+// Front stubs generate the schedule backward from the termination date, back stubs forward from the effective date.
+type Stub uint8
+
+const (
+	// The irregular period is the first one and it is shorter than a regular period; the schedule is generated backward.
+	ShortFrontStub Stub = iota
+	// The irregular period is the first one and it is longer than a regular period; the schedule is generated backward.
+	LongFrontStub
+	// The irregular period is the last one and it is shorter than a regular period; the schedule is generated forward.
+	ShortBackStub
+	// The irregular period is the last one and it is longer than a regular period; the schedule is generated forward.
+	LongBackStub
+)
+
+var stubNames = [...]string{
+	ShortFrontStub: "short-front",
+	LongFrontStub:  "long-front",
+	ShortBackStub:  "short-back",
+	LongBackStub:   "long-back",
+}
+
+// Returns the name of the stub, for example short-front.
+func (stub Stub) String() string {
+	if int(stub) < len(stubNames) {
+		return stubNames[stub]
+	}
+	return fmt.Sprintf("Stub(%d)", uint8(stub))
+}
+
+// Implements the [encoding.TextMarshaler] interface.
+//
+// # Returns
+//
+//	data []byte
+//
+// The name of the stub.
+//
+//	err error
+//
+// An error if the stub is not valid.
+func (stub Stub) MarshalText() (data []byte, err error) {
+	if int(stub) >= len(stubNames) {
+		return nil, fmt.Errorf("date.Stub.MarshalText: invalid stub %d", uint8(stub))
+	}
+	return []byte(stubNames[stub]), nil
+}
+
+// Implements the [encoding.TextUnmarshaler] interface.
+//
+// # Parameters
+//
+//	data []byte
+//
+// Text data.
+//
+// # Returns
+//
+//	err error
+//
+// An error if data is not a known stub.
+func (stub *Stub) UnmarshalText(data []byte) error {
+	i := slices.Index(stubNames[:], string(data))
+	if i < 0 {
+		return fmt.Errorf("date.Stub.UnmarshalText: unknown stub %q", data)
+	}
+	*stub = Stub(i)
+	return nil
+}
+
+// Specifies the day of the month on which the regular dates of a schedule with a monthly or yearly frequency fall.
+//
+// # Remarks
+//
+// The values 1 to 31 are days of the month; a day after the end of a month is the last day of the month.
+// The zero value rolls on the day of the date the schedule is generated from.
+type RollDay int8
+
+const (
+	// The regular dates fall on the last day of the month.
+	RollEndOfMonth RollDay = -1
+	// The regular dates fall on the third Wednesday of the month (IMM dates).
+	RollIMM RollDay = -2
+)
+
+// Builds payment schedules from an effective date to a termination date.
+type ScheduleBuilder struct {
+	// The start of the first period.
+	Effective Date
+	// The end of the last period.
+	Termination Date
+	// The length of a regular period, for example P3M; it can be created with ParseTenor.
+	// It must be positive and either in years and months or in weeks and days.
+	Frequency Period
+	// The business-day calendar used to adjust the dates; nil if every day is a business day.
+	Calendar Calendar
+	// The business-day convention used to adjust the dates.
+	Convention BusinessDayConvention
+	// The day of the month of the regular dates; it is ignored for frequencies in weeks and days.
+	RollDay RollDay
+	// The placement and the length of the irregular period, if the dates do not divide into regular periods.
+	Stub Stub
+	// The number of business days between the adjusted end of a period and its payment date.
+	PaymentLag int
+}
+
+// Represents a period of a schedule.
+type SchedulePeriod struct {
+	// The unadjusted start of the period.
+	UnadjustedStart Date
+	// The unadjusted end of the period.
+	UnadjustedEnd Date
+	// The start of the period adjusted by the business-day convention.
+	Start Date
+	// The end of the period adjusted by the business-day convention.
+	End Date
+	// The payment date.
+	Payment Date
+	// True if the period is the irregular period of the schedule.
+	IsStub bool
+}
+
+// Generates the periods of the schedule.
+//
+// # Returns
+//
+//	periods []SchedulePeriod
+//
+// The periods in chronological order.
+//
+//	err error
+//
+// An error if Effective is not before Termination, or Frequency, RollDay, or Stub is not valid.
+//
+// # Remarks
+//
+// Regular dates are computed from the effective or termination date by whole multiples of Frequency and then moved to RollDay, so they do not drift at month ends.
+// If the dates divide into regular periods, no period is a stub.
+// If the effective date (or the termination date for front stubs) does not fall on RollDay, the regular dates start from the first roll date
+// after it (or before it), and the period between them is also a short stub, so a schedule may have a stub at both ends.
+// A long stub is a short stub merged with its neighboring regular period; if there is no such period, the stub is the only period.
+func (builder ScheduleBuilder) Build() (periods []SchedulePeriod, err error) {
+	if !builder.Effective.Before(builder.Termination) {
+		return nil, errors.New("date.ScheduleBuilder.Build: effective date is not before termination date")
+	}
+	frequency := builder.Frequency.Normalize()
+	months, days := 12*frequency.Years+frequency.Months, frequency.Days
+	if months < 0 || days < 0 || (months == 0) == (days == 0) {
+		return nil, fmt.Errorf("date.ScheduleBuilder.Build: invalid frequency %v", builder.Frequency)
+	}
+	if builder.RollDay < RollIMM || builder.RollDay > 31 {
+		return nil, fmt.Errorf("date.ScheduleBuilder.Build: invalid roll day %d", builder.RollDay)
+	}
+	if int(builder.Stub) >= len(stubNames) {
+		return nil, fmt.Errorf("date.ScheduleBuilder.Build: invalid stub %d", uint8(builder.Stub))
+	}
+	backward := builder.Stub == ShortFrontStub || builder.Stub == LongFrontStub
+	anchor, sign := builder.Effective, 1
+	if backward {
+		anchor, sign = builder.Termination, -1
+	}
+	var dates []Date
+	stub := false
+	// The period between the anchor and the first roll date is irregular if the anchor is not on a roll date.
+	rollStub := builder.regularDate(anchor, 0, months, days) != anchor
+	for n := 0; ; n++ {
+		date := builder.regularDate(anchor, sign*n, months, days)
+		if !date.After(builder.Effective) || !date.Before(builder.Termination) {
+			if date == anchor || (backward && date.After(builder.Effective)) || (!backward && date.Before(builder.Termination)) {
+				continue
+			}
+			stub = date != builder.Effective && date != builder.Termination
+			break
+		}
+		dates = append(dates, date)
+	}
+	if stub && len(dates) > 0 && (builder.Stub == LongFrontStub || builder.Stub == LongBackStub) {
+		dates = dates[:len(dates)-1]
+	}
+	if backward {
+		slices.Reverse(dates)
+	}
+	dates = slices.Concat([]Date{builder.Effective}, dates, []Date{builder.Termination})
+	periods = make([]SchedulePeriod, len(dates)-1)
+	for i := range periods {
+		periods[i] = SchedulePeriod{
+			UnadjustedStart: dates[i],
+			UnadjustedEnd:   dates[i+1],
+			Start:           builder.adjust(dates[i]),
+			End:             builder.adjust(dates[i+1]),
+		}
+		if builder.Calendar != nil {
+			periods[i].Payment = periods[i].End.AddBusinessDays(builder.PaymentLag, builder.Calendar)
+		} else {
+			periods[i].Payment = periods[i].End.AddDays(builder.PaymentLag)
+		}
+	}
+	if stub {
+		if backward {
+			periods[0].IsStub = true
+		} else {
+			periods[len(periods)-1].IsStub = true
+		}
+	}
+	if rollStub {
+		if backward {
+			periods[len(periods)-1].IsStub = true
+		} else {
+			periods[0].IsStub = true
+		}
+	}
+	return periods, nil
+}
+
+// Returns the unadjusted regular date n periods from anchor.
+func (builder ScheduleBuilder) regularDate(anchor Date, n int, months int, days int) Date {
+	if months == 0 {
+		return anchor.AddDays(n * days)
+	}
+	first := New(anchor.Year(), anchor.Month()+time.Month(n*months), 1)
+	last := first.AddMonths(1).AddDays(-1)
+	switch builder.RollDay {
+	case 0:
+		return first.AddDays(min(anchor.Day(), last.Day()) - 1)
+	case RollEndOfMonth:
+		return last
+	case RollIMM:
+		date, _ := NthWeekdayOfMonth(first.Year(), first.Month(), time.Wednesday, 3)
+		return date
+	default:
+		return first.AddDays(min(int(builder.RollDay), last.Day()) - 1)
+	}
+}
+
+// Adjusts date by the convention of the builder.
+func (builder ScheduleBuilder) adjust(date Date) Date {
+	if builder.Calendar == nil {
+		return date
+	}
+	return Adjust(date, builder.Convention, builder.Calendar)
+}
@@ -0,0 +1,198 @@
+package date
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Returns the unadjusted end dates of periods, with a trailing * on stub periods.
+func scheduleEnds(periods []SchedulePeriod) []string {
+	ends := make([]string, len(periods))
+	for i, period := range periods {
+		ends[i] = period.UnadjustedEnd.String()
+		if period.IsStub {
+			ends[i] += "*"
+		}
+	}
+	return ends
+}
+
+func TestScheduleBuilder_Build(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder ScheduleBuilder
+		want    []string
+	}{
+		{
+			name:    "Short front stub",
+			builder: ScheduleBuilder{Effective: New(2024, time.February, 1), Termination: New(2025, time.June, 15), Frequency: Period{Months: 6}},
+			want:    []string{"2024-06-15*", "2024-12-15", "2025-06-15"},
+		},
+		{
+			name:    "Long front stub",
+			builder: ScheduleBuilder{Effective: New(2024, time.February, 1), Termination: New(2025, time.June, 15), Frequency: Period{Months: 6}, Stub: LongFrontStub},
+			want:    []string{"2024-12-15*", "2025-06-15"},
+		},
+		{
+			name:    "Short back stub",
+			builder: ScheduleBuilder{Effective: New(2024, time.February, 1), Termination: New(2025, time.June, 15), Frequency: Period{Months: 6}, Stub: ShortBackStub},
+			want:    []string{"2024-08-01", "2025-02-01", "2025-06-15*"},
+		},
+		{
+			name:    "Long back stub",
+			builder: ScheduleBuilder{Effective: New(2024, time.February, 1), Termination: New(2025, time.June, 15), Frequency: Period{Months: 6}, Stub: LongBackStub},
+			want:    []string{"2024-08-01", "2025-06-15*"},
+		},
+		{
+			name:    "Long stub only",
+			builder: ScheduleBuilder{Effective: New(2024, time.February, 1), Termination: New(2024, time.April, 15), Frequency: Period{Months: 6}, Stub: LongFrontStub},
+			want:    []string{"2024-04-15*"},
+		},
+		{
+			name:    "Regular",
+			builder: ScheduleBuilder{Effective: New(2024, time.January, 31), Termination: New(2025, time.January, 31), Frequency: Period{Years: 1}},
+			want:    []string{"2025-01-31"},
+		},
+		{
+			name:    "No drift at month end",
+			builder: ScheduleBuilder{Effective: New(2024, time.January, 31), Termination: New(2024, time.May, 31), Frequency: Period{Months: 1}, Stub: ShortBackStub},
+			want:    []string{"2024-02-29", "2024-03-31", "2024-04-30", "2024-05-31"},
+		},
+		{
+			name:    "End of month roll",
+			builder: ScheduleBuilder{Effective: New(2024, time.February, 29), Termination: New(2024, time.June, 30), Frequency: Period{Months: 1}, RollDay: RollEndOfMonth, Stub: ShortBackStub},
+			want:    []string{"2024-03-31", "2024-04-30", "2024-05-31", "2024-06-30"},
+		},
+		{
+			name:    "Day of month roll",
+			builder: ScheduleBuilder{Effective: New(2024, time.January, 5), Termination: New(2024, time.April, 20), Frequency: Period{Months: 1}, RollDay: 20},
+			want:    []string{"2024-01-20*", "2024-02-20", "2024-03-20", "2024-04-20"},
+		},
+		{
+			name:    "Day of month roll after effective - forward",
+			builder: ScheduleBuilder{Effective: New(2024, time.January, 15), Termination: New(2024, time.April, 20), Frequency: Period{Months: 1}, RollDay: 20, Stub: ShortBackStub},
+			want:    []string{"2024-01-20*", "2024-02-20", "2024-03-20", "2024-04-20"},
+		},
+		{
+			name:    "Day of month roll after effective - forward with back stub",
+			builder: ScheduleBuilder{Effective: New(2024, time.January, 15), Termination: New(2024, time.April, 25), Frequency: Period{Months: 1}, RollDay: 20, Stub: ShortBackStub},
+			want:    []string{"2024-01-20*", "2024-02-20", "2024-03-20", "2024-04-20", "2024-04-25*"},
+		},
+		{
+			name:    "Day of month roll after effective - forward with long back stub",
+			builder: ScheduleBuilder{Effective: New(2024, time.January, 15), Termination: New(2024, time.April, 25), Frequency: Period{Months: 1}, RollDay: 20, Stub: LongBackStub},
+			want:    []string{"2024-01-20*", "2024-02-20", "2024-03-20", "2024-04-25*"},
+		},
+		{
+			name:    "Day of month roll before effective - forward",
+			builder: ScheduleBuilder{Effective: New(2024, time.January, 25), Termination: New(2024, time.April, 20), Frequency: Period{Months: 1}, RollDay: 20, Stub: ShortBackStub},
+			want:    []string{"2024-02-20*", "2024-03-20", "2024-04-20"},
+		},
+		{
+			name:    "Day of month roll after effective - backward",
+			builder: ScheduleBuilder{Effective: New(2024, time.January, 15), Termination: New(2024, time.April, 25), Frequency: Period{Months: 1}, RollDay: 20},
+			want:    []string{"2024-01-20*", "2024-02-20", "2024-03-20", "2024-04-20", "2024-04-25*"},
+		},
+		{
+			name:    "Day of month roll before termination - backward",
+			builder: ScheduleBuilder{Effective: New(2024, time.January, 20), Termination: New(2024, time.April, 15), Frequency: Period{Months: 1}, RollDay: 20},
+			want:    []string{"2024-02-20", "2024-03-20", "2024-04-15*"},
+		},
+		{
+			name:    "IMM roll",
+			builder: ScheduleBuilder{Effective: New(2024, time.March, 20), Termination: New(2025, time.March, 19), Frequency: Period{Months: 3}, RollDay: RollIMM},
+			want:    []string{"2024-06-19", "2024-09-18", "2024-12-18", "2025-03-19"},
+		},
+		{
+			name:    "Weekly",
+			builder: ScheduleBuilder{Effective: New(2024, time.January, 1), Termination: New(2024, time.January, 25), Frequency: Period{Weeks: 1}, Stub: ShortBackStub},
+			want:    []string{"2024-01-08", "2024-01-15", "2024-01-22", "2024-01-25*"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			periods, err := tt.builder.Build()
+			if err != nil {
+				t.Fatalf("ScheduleBuilder.Build() error = %v", err)
+			}
+			if got := scheduleEnds(periods); !slices.Equal(got, tt.want) {
+				t.Errorf("ScheduleBuilder.Build() = %v, want %v", got, tt.want)
+			}
+			for i := 1; i < len(periods); i++ {
+				if periods[i].UnadjustedStart != periods[i-1].UnadjustedEnd || periods[i].Start != periods[i-1].End {
+					t.Errorf("ScheduleBuilder.Build() period %d does not start at the end of the previous period", i)
+				}
+			}
+		})
+	}
+}
+
+func TestScheduleBuilder_Build_Adjusted(t *testing.T) {
+	holidays := NewHolidaySet(New(2024, time.December, 16))
+	builder := ScheduleBuilder{
+		Effective:   New(2024, time.February, 1),
+		Termination: New(2025, time.June, 15),
+		Frequency:   Period{Months: 6},
+		Calendar:    NewBusinessCalendar(SaturdaySunday, holidays.Contains),
+		Convention:  ModifiedFollowing,
+		PaymentLag:  2,
+	}
+	periods, err := builder.Build()
+	if err != nil {
+		t.Fatalf("ScheduleBuilder.Build() error = %v", err)
+	}
+	want := []SchedulePeriod{
+		{
+			UnadjustedStart: New(2024, time.February, 1), UnadjustedEnd: New(2024, time.June, 15),
+			Start: New(2024, time.February, 1), End: New(2024, time.June, 17), Payment: New(2024, time.June, 19), IsStub: true,
+		},
+		{
+			UnadjustedStart: New(2024, time.June, 15), UnadjustedEnd: New(2024, time.December, 15),
+			Start: New(2024, time.June, 17), End: New(2024, time.December, 17), Payment: New(2024, time.December, 19),
+		},
+		{
+			UnadjustedStart: New(2024, time.December, 15), UnadjustedEnd: New(2025, time.June, 15),
+			Start: New(2024, time.December, 17), End: New(2025, time.June, 16), Payment: New(2025, time.June, 18),
+		},
+	}
+	if !slices.Equal(periods, want) {
+		t.Errorf("ScheduleBuilder.Build() = %+v, want %+v", periods, want)
+	}
+}
+
+func TestScheduleBuilder_Build_Errors(t *testing.T) {
+	effective, termination := New(2024, time.January, 1), New(2025, time.January, 1)
+	tests := []struct {
+		name    string
+		builder ScheduleBuilder
+		wantErr string
+	}{
+		{name: "Reversed dates", builder: ScheduleBuilder{Effective: termination, Termination: effective, Frequency: Period{Months: 3}}, wantErr: "not before"},
+		{name: "Zero frequency", builder: ScheduleBuilder{Effective: effective, Termination: termination}, wantErr: "frequency"},
+		{name: "Mixed frequency", builder: ScheduleBuilder{Effective: effective, Termination: termination, Frequency: Period{Months: 1, Days: 1}}, wantErr: "frequency"},
+		{name: "Invalid roll day", builder: ScheduleBuilder{Effective: effective, Termination: termination, Frequency: Period{Months: 1}, RollDay: 32}, wantErr: "roll day"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.builder.Build()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ScheduleBuilder.Build() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStub_Text(t *testing.T) {
+	for stub := ShortFrontStub; stub <= LongBackStub; stub++ {
+		data, err := stub.MarshalText()
+		if err != nil {
+			t.Fatalf("Stub.MarshalText() error = %v", err)
+		}
+		var got Stub
+		if err := got.UnmarshalText(data); err != nil || got != stub {
+			t.Errorf("Stub.UnmarshalText(%q) = %v, %v, want %v", data, got, err, stub)
+		}
+	}
+}
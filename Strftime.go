@@ -0,0 +1,349 @@
+package date
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Returns the date formatted according to a strftime pattern, as in C and the date command.
+//
+// # Parameters
+//
+//	pattern string
+//
+// The pattern, for example %Y-%m-%d or %G-W%V-%u.
+//
+// # Returns
+//
+//	result string
+//
+// The formatted date.
+//
+// # Remarks
+//
+// The supported directives are those of POSIX and glibc that refer to the date, with English names as in the C locale:
+//   - %a, %A: the abbreviated and full weekday name; %b or %h, %B: the abbreviated and full month name;
+//   - %C: the century; %y: the last two digits of the year; %Y: the year;
+//   - %m: the month, 01 to 12; %d: the day of the month, 01 to 31; %e: the same, padded with a space;
+//   - %j: the day of the year, 001 to 366;
+//   - %U: the week of the year, 00 to 53, with weeks starting on Sunday and days before the first Sunday in week 00;
+//   - %W: the same with weeks starting on Monday;
+//   - %G: the ISO 8601 week-numbering year; %g: its last two digits; %V: the ISO 8601 week, 01 to 53;
+//   - %u: the weekday, 1 for Monday to 7 for Sunday; %w: the weekday, 0 for Sunday to 6 for Saturday;
+//   - %D and %x: %m/%d/%y; %F: %Y-%m-%d; %n: a newline; %t: a tab; %%: a percent sign.
+//
+// The glibc flags - (no padding), _ (padding with spaces), 0 (padding with zeros), and ^ (upper case) may follow the percent sign.
+// Other directives, including the time directives, are written unchanged.
+func (date Date) Strftime(pattern string) (result string) {
+	return string(date.appendStrftime(nil, pattern))
+}
+
+func (date Date) appendStrftime(bytes []byte, pattern string) []byte {
+	year, month, day := date.Deconstruct()
+	weekday, yearDay := int(date.Weekday()), date.YearDay()
+	isoYear, isoWeek := date.ISOWeek()
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] != '%' || i+1 == len(pattern) {
+			bytes = append(bytes, pattern[i])
+			continue
+		}
+		start := i
+		i++
+		var flag byte
+		if strings.IndexByte("-_0^", pattern[i]) >= 0 && i+1 < len(pattern) {
+			flag = pattern[i]
+			i++
+		}
+		text, number, width, pad := "", 0, 2, byte('0')
+		switch pattern[i] {
+		case 'a':
+			text = englishShortWeekdays[weekday]
+		case 'A':
+			text = englishWeekdays[weekday]
+		case 'b', 'h':
+			text = englishShortMonths[month-1]
+		case 'B':
+			text = englishMonths[month-1]
+		case 'C':
+			number = year / 100
+		case 'd':
+			number = day
+		case 'D', 'x':
+			text = date.Strftime("%m/%d/%y")
+		case 'e':
+			number, pad = day, ' '
+		case 'F':
+			text = date.Strftime("%Y-%m-%d")
+		case 'g':
+			number = isoYear % 100
+		case 'G':
+			number, width = isoYear, 1
+		case 'j':
+			number, width = yearDay, 3
+		case 'm':
+			number = int(month)
+		case 'n':
+			text = "\n"
+		case 't':
+			text = "\t"
+		case 'u':
+			number, width = (weekday+6)%7+1, 1
+		case 'U':
+			number = (yearDay - 1 + 7 - weekday) / 7
+		case 'V':
+			number = isoWeek
+		case 'w':
+			number, width = weekday, 1
+		case 'W':
+			number = (yearDay - 1 + 7 - (weekday+6)%7) / 7
+		case 'y':
+			number = year % 100
+		case 'Y':
+			number, width = year, 1
+		case '%':
+			text = "%"
+		default:
+			bytes = append(bytes, pattern[start:i+1]...)
+			continue
+		}
+		if text != "" {
+			if flag == '^' {
+				text = strings.ToUpper(text)
+			}
+			bytes = append(bytes, text...)
+			continue
+		}
+		switch flag {
+		case '-':
+			width = 0
+		case '_':
+			pad = ' '
+		case '0':
+			pad = '0'
+		}
+		digits := strconv.Itoa(number)
+		for n := len(digits); n < width; n++ {
+			bytes = append(bytes, pad)
+		}
+		bytes = append(bytes, digits...)
+	}
+	return bytes
+}
+
+// The composite directives of strptime and their expansions.
+var strptimeExpansions = strings.NewReplacer("%%", "%%", "%D", "%m/%d/%y", "%x", "%m/%d/%y", "%F", "%Y-%m-%d")
+
+// The maximum number of digits of the numeric directives of strptime.
+var strptimeDigits = map[byte]int{
+	'C': 2, 'd': 2, 'e': 2, 'g': 2, 'G': 4, 'j': 3, 'm': 2, 'u': 1, 'U': 2, 'V': 2, 'w': 1, 'W': 2, 'y': 2, 'Y': 4,
+}
+
+// Parses a date according to a strptime pattern, as in C.
+//
+// # Parameters
+//
+//	pattern string
+//
+// The pattern, with the directives of Strftime.
+//
+//	value string
+//
+// The date to parse.
+//
+// # Returns
+//
+//	date Date
+//
+// The parsed date.
+//
+//	err error
+//
+// An error if value does not match pattern, pattern has an unsupported directive, or the fields are not consistent.
+//
+// # Remarks
+//
+// As in POSIX, white space in pattern, %n, and %t match any amount of white space, numbers may have fewer digits
+// or leading spaces, names are matched case-insensitively in the abbreviated or full form, and flags are ignored.
+// A year %y without a century %C is in the range 1969 to 2068; with %C it is in that century.
+//
+// The date is taken from %G (or %g) and %V if the pattern has an ISO 8601 week, otherwise from the year and %j if it has a day of the year,
+// otherwise from the year, %U or %W and the weekday if it has a week, otherwise from the year, month, and day.
+// A missing year is 1 and a missing month, day, week, or weekday is the first one. The remaining fields must agree with that date.
+func Strptime(pattern string, value string) (date Date, err error) {
+	var values [128]int
+	var set [128]bool
+	pattern = strptimeExpansions.Replace(pattern)
+	fail := func(reason string) (Date, error) {
+		return Date{}, fmt.Errorf("date.Strptime: cannot parse %q as %q: %s", value, pattern, reason)
+	}
+	rest := value
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case isSpace(c):
+			rest = strings.TrimLeft(rest, " \t\n\v\f\r")
+			continue
+		case c != '%' || i+1 == len(pattern):
+			if rest == "" || rest[0] != c {
+				return fail(fmt.Sprintf("expected %q at %q", c, rest))
+			}
+			rest = rest[1:]
+			continue
+		}
+		i++
+		if strings.IndexByte("-_0^", pattern[i]) >= 0 && i+1 < len(pattern) {
+			i++
+		}
+		directive, v, n := pattern[i], 0, 0
+		switch directive {
+		case 'n', 't':
+			rest = strings.TrimLeft(rest, " \t\n\v\f\r")
+			continue
+		case '%':
+			if !strings.HasPrefix(rest, "%") {
+				return fail(fmt.Sprintf("expected '%%' at %q", rest))
+			}
+			rest = rest[1:]
+			continue
+		case 'a', 'A':
+			if n, v = matchName(rest, [][]string{englishWeekdays[:], englishShortWeekdays[:]}); v < 0 {
+				return fail(fmt.Sprintf("expected a weekday name at %q", rest))
+			}
+			directive = 'w'
+		case 'b', 'B', 'h':
+			if n, v = matchName(rest, [][]string{englishMonths[:], englishShortMonths[:]}); v < 0 {
+				return fail(fmt.Sprintf("expected a month name at %q", rest))
+			}
+			directive, v = 'm', v+1
+		default:
+			digits, ok := strptimeDigits[directive]
+			if !ok {
+				return fail(fmt.Sprintf("unsupported directive %%%c", directive))
+			}
+			rest = strings.TrimLeft(rest, " ")
+			for n < len(rest) && n < digits && '0' <= rest[n] && rest[n] <= '9' {
+				n++
+			}
+			if n == 0 {
+				return fail(fmt.Sprintf("expected a number at %q", rest))
+			}
+			v, _ = strconv.Atoi(rest[:n])
+			switch directive {
+			case 'e':
+				directive = 'd'
+			case 'u':
+				if v < 1 || v > 7 {
+					return fail("weekday out of range")
+				}
+				directive, v = 'w', v%7
+			}
+		}
+		if set[directive] && values[directive] != v {
+			return fail(fmt.Sprintf("conflicting values of %%%c", directive))
+		}
+		values[directive], set[directive] = v, true
+		rest = rest[n:]
+	}
+	if rest != "" {
+		return fail(fmt.Sprintf("extra text %q", rest))
+	}
+	return strptimeResolve(values, set, fail)
+}
+
+// Returns the date of the parsed fields of Strptime.
+func strptimeResolve(values [128]int, set [128]bool, fail func(reason string) (Date, error)) (date Date, err error) {
+	year := 1
+	switch {
+	case set['Y']:
+		year = values['Y']
+	case set['C'] && set['y']:
+		year = 100*values['C'] + values['y']
+	case set['C']:
+		year = 100 * values['C']
+	case set['y']:
+		year = pivotYear(values['y'])
+	}
+	if set['m'] && (values['m'] < 1 || values['m'] > 12) {
+		return fail("month out of range")
+	}
+	if set['w'] && values['w'] > 6 {
+		return fail("weekday out of range")
+	}
+	weekday := time.Weekday(values['w'])
+	switch {
+	case set['V'] || set['G'] || set['g']:
+		isoYear, week := year, 1
+		if set['G'] {
+			isoYear = values['G']
+		} else if set['g'] {
+			isoYear = pivotYear(values['g'])
+		}
+		if set['V'] {
+			week = values['V']
+		}
+		if !set['w'] {
+			weekday = time.Monday
+		}
+		if week < 1 || week > weeksInISOYear(isoYear) {
+			return fail("week out of range")
+		}
+		date = FromISOWeek(isoYear, week, weekday)
+	case set['j']:
+		date = New(year, time.January, 1)
+		if values['j'] < 1 || values['j'] > date.DaysInYear() {
+			return fail("day of year out of range")
+		}
+		date = date.AddDays(values['j'] - 1)
+	case set['U'] || set['W']:
+		january1 := New(year, time.January, 1)
+		var yearDay int
+		if set['U'] {
+			// Week 1 starts on the first Sunday of the year.
+			yearDay = (7-int(january1.Weekday()))%7 + 7*(values['U']-1) + int(weekday)
+		} else {
+			if !set['w'] {
+				weekday = time.Monday
+			}
+			// Week 1 starts on the first Monday of the year.
+			yearDay = (8-int(january1.Weekday()))%7 + 7*(values['W']-1) + (int(weekday)+6)%7
+		}
+		if yearDay < 0 || yearDay >= january1.DaysInYear() {
+			return fail("week out of range")
+		}
+		date = january1.AddDays(yearDay)
+	default:
+		month, day := 1, 1
+		if set['m'] {
+			month = values['m']
+		}
+		if set['d'] {
+			day = values['d']
+		}
+		var ok bool
+		if date, ok = validDate(year, month, day); !ok {
+			return fail("day out of range")
+		}
+	}
+	switch {
+	case set['m'] && int(date.Month()) != values['m'],
+		set['d'] && date.Day() != values['d'],
+		set['w'] && date.Weekday() != time.Weekday(values['w']):
+		return fail(fmt.Sprintf("fields do not agree with %v", date))
+	}
+	return date, nil
+}
+
+// Returns the year of a two-digit year according to POSIX: 69 to 99 are 1969 to 1999, 00 to 68 are 2000 to 2068.
+func pivotYear(year int) int {
+	if year < 69 {
+		return 2000 + year
+	}
+	return 1900 + year
+}
+
+// Reports whether c is an ASCII white space character.
+func isSpace(c byte) bool {
+	return c == ' ' || '\t' <= c && c <= '\r'
+}
@@ -0,0 +1,90 @@
+package date
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDate_Strftime(t *testing.T) {
+	tests := []struct {
+		date    Date
+		pattern string
+		want    string
+	}{
+		{date: New(2024, time.May, 5), pattern: "%Y-%m-%d", want: "2024-05-05"},
+		{date: New(2024, time.May, 5), pattern: "%F %D %x", want: "2024-05-05 05/05/24 05/05/24"},
+		{date: New(2024, time.May, 5), pattern: "%a %A %b %h %B", want: "Sun Sunday May May May"},
+		{date: New(2024, time.May, 5), pattern: "[%e] [%-d] [%_m] [%^a] [%0e]", want: "[ 5] [5] [ 5] [SUN] [05]"},
+		{date: New(2024, time.December, 31), pattern: "%j", want: "366"},
+		{date: New(2024, time.December, 30), pattern: "%G-W%V-%u %g", want: "2025-W01-1 25"},
+		{date: New(2021, time.January, 3), pattern: "%G-W%V-%u %U %W %w", want: "2020-W53-7 01 00 0"},
+		{date: New(2023, time.January, 1), pattern: "%U %W", want: "01 00"},
+		{date: New(2024, time.January, 1), pattern: "%U %W", want: "00 01"},
+		{date: New(1999, time.June, 1), pattern: "%C %y", want: "19 99"},
+		{date: New(2024, time.May, 5), pattern: "%H:%M %Q 100%% %n%t%", want: "%H:%M %Q 100% \n\t%"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			if got := tt.date.Strftime(tt.pattern); got != tt.want {
+				t.Errorf("Date.Strftime() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrptime(t *testing.T) {
+	tests := []struct {
+		pattern string
+		value   string
+		want    Date
+		wantErr bool
+	}{
+		{pattern: "%Y-%m-%d", value: "2024-05-15", want: New(2024, time.May, 15)},
+		{pattern: "%Y-%m-%d", value: "2024-5-7", want: New(2024, time.May, 7)},
+		{pattern: "%F", value: "2024-05-15", want: New(2024, time.May, 15)},
+		{pattern: "%D", value: "05/15/68", want: New(2068, time.May, 15)},
+		{pattern: "%D", value: "05/15/69", want: New(1969, time.May, 15)},
+		{pattern: "%C%y%m%d", value: "19450508", want: New(1945, time.May, 8)},
+		{pattern: "%d %b %Y", value: "15 may 2024", want: New(2024, time.May, 15)},
+		{pattern: "%A, %B %e, %Y", value: "Wednesday, May  1, 2024", want: New(2024, time.May, 1)},
+		{pattern: "%a %d.%m.%Y", value: "Mon 15.05.2024", wantErr: true},
+		{pattern: "%Y %j", value: "2024 060", want: New(2024, time.February, 29)},
+		{pattern: "%Y %j", value: "2023 366", wantErr: true},
+		{pattern: "%G-W%V-%u", value: "2020-W53-7", want: New(2021, time.January, 3)},
+		{pattern: "%G-W%V", value: "2025-W01", want: New(2024, time.December, 30)},
+		{pattern: "%G-W%V-%u", value: "2021-W53-1", wantErr: true},
+		{pattern: "%G-W%V-%u", value: "2021-W01-8", wantErr: true},
+		{pattern: "%Y %U %a", value: "2021 00 Sat", want: New(2021, time.January, 2)},
+		{pattern: "%Y %U", value: "2021 01", want: New(2021, time.January, 3)},
+		{pattern: "%Y %W", value: "2024 01", want: New(2024, time.January, 1)},
+		{pattern: "%Y %W %w", value: "2021 00 5", want: New(2021, time.January, 1)},
+		{pattern: "%Y %W %w", value: "2021 00 4", wantErr: true},
+		{pattern: "%Y-%m-%d", value: "2024-02-30", wantErr: true},
+		{pattern: "%Y-%m-%d", value: "2024-13-01", wantErr: true},
+		{pattern: "%Y-%m-%d", value: "2024-05-15x", wantErr: true},
+		{pattern: "%Y-%m-%d %H:%M", value: "2024-05-15 10:00", wantErr: true},
+		{pattern: "%Y%n%m", value: "2024 \t 05", want: New(2024, time.May, 1)},
+		{pattern: "%m/%d %%", value: "05/15 %", want: New(1, time.May, 15)},
+		{pattern: "%m %B", value: "05 June", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.value, func(t *testing.T) {
+			got, err := Strptime(tt.pattern, tt.value)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("Strptime() = %v, %v, want %v, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStrptime_RoundTrip(t *testing.T) {
+	patterns := []string{"%Y-%m-%d", "%G-W%V-%u", "%Y %U %w", "%Y %W %a", "%C%y-%j", "%A %e %B %Y"}
+	for date := range NewRange(New(2019, time.December, 1), New(2027, time.January, 31)).Days() {
+		for _, pattern := range patterns {
+			value := date.Strftime(pattern)
+			if got, err := Strptime(pattern, value); err != nil || got != date {
+				t.Fatalf("Strptime(%q, %q) = %v, %v, want %v", pattern, value, got, err, date)
+			}
+		}
+	}
+}
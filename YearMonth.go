@@ -0,0 +1,450 @@
+package date
+
+import (
+	"cmp"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+	"time"
+)
+
+// Represents a month of a year, such as 2024-05.
+//
+// # Remarks
+//
+// YearMonth is stored as the number of months elapsed since January, year 1.
+// The zero value is 0001-01. YearMonth is comparable, so it can be compared with == and used as a map key.
+type YearMonth struct {
+	months int32
+}
+
+// Creates a new instance of the YearMonth structure to the specified year and month.
+//
+// # Parameters
+//
+//	year int
+//
+// The year.
+//
+//	month time.Month
+//
+// The month. Values outside January through December are normalized, so month 13 is January of the next year.
+//
+// # Returns
+//
+//	yearMonth YearMonth
+//
+// The month of the year.
+func NewYearMonth(year int, month time.Month) (yearMonth YearMonth) {
+	return YearMonth{months: int32((year-1)*12 + int(month-1))}
+}
+
+// Returns the month containing the date.
+//
+// # Returns
+//
+//	yearMonth YearMonth
+//
+// The month of date.
+func (date Date) YearMonth() (yearMonth YearMonth) {
+	year, month, _ := date.Deconstruct()
+	return NewYearMonth(year, month)
+}
+
+// Parses a month in the YYYY-MM, YYYYMM, or MM/YY format.
+//
+// # Parameters
+//
+//	value string
+//
+// The month to parse, for example 2024-05, 202405, or 05/24.
+//
+// # Returns
+//
+//	yearMonth YearMonth
+//
+// The parsed month.
+//
+//	err error
+//
+// An error if value is not a valid month.
+//
+// # Remarks
+//
+// A two-digit year YY of the MM/YY format, used for example for card expiry dates, is the year 20YY.
+func ParseYearMonth(value string) (yearMonth YearMonth, err error) {
+	var year, month string
+	switch {
+	case len(value) == 7 && value[4] == '-':
+		year, month = value[:4], value[5:]
+	case len(value) == 6:
+		year, month = value[:4], value[4:]
+	case len(value) == 5 && value[2] == '/':
+		year, month = "20"+value[3:], value[:2]
+	default:
+		return YearMonth{}, fmt.Errorf("date.ParseYearMonth: invalid month %q", value)
+	}
+	y, err := parseDigits(year)
+	if err != nil {
+		return YearMonth{}, fmt.Errorf("date.ParseYearMonth: invalid year in %q", value)
+	}
+	m, err := parseDigits(month)
+	if err != nil || m < 1 || m > 12 {
+		return YearMonth{}, fmt.Errorf("date.ParseYearMonth: invalid month in %q", value)
+	}
+	return NewYearMonth(y, time.Month(m)), nil
+}
+
+// Parses a non-empty string of ASCII digits.
+func parseDigits(value string) (int, error) {
+	for i := 0; i < len(value); i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return 0, errors.New("not a number")
+		}
+	}
+	return strconv.Atoi(value)
+}
+
+// Returns the year.
+func (yearMonth YearMonth) Year() int {
+	return int(floorDiv(int64(yearMonth.months), 12)) + 1
+}
+
+// Returns the month of the year.
+func (yearMonth YearMonth) Month() time.Month {
+	return time.Month(int64(yearMonth.months)-12*floorDiv(int64(yearMonth.months), 12)) + 1
+}
+
+// Returns the first day of the month.
+//
+// # Returns
+//
+//	first Date
+//
+// The first day of the month.
+func (yearMonth YearMonth) First() (first Date) {
+	return New(yearMonth.Year(), yearMonth.Month(), 1)
+}
+
+// Returns the last day of the month.
+//
+// # Returns
+//
+//	last Date
+//
+// The last day of the month.
+func (yearMonth YearMonth) Last() (last Date) {
+	return yearMonth.AddMonths(1).First().AddDays(-1)
+}
+
+// Returns the number of days in the month.
+//
+// # Returns
+//
+//	days int
+//
+// The number of days, from 28 to 31.
+func (yearMonth YearMonth) DaysIn() (days int) {
+	return yearMonth.Last().Day()
+}
+
+// Returns the days of the month as a range.
+//
+// # Returns
+//
+//	result Range
+//
+// The range from First() to Last().
+func (yearMonth YearMonth) Range() (result Range) {
+	return NewRange(yearMonth.First(), yearMonth.Last())
+}
+
+// Returns an iterator over the days of the month.
+//
+// # Returns
+//
+//	seq iter.Seq[Date]
+//
+// The iterator over the days from First() to Last().
+func (yearMonth YearMonth) Days() (seq iter.Seq[Date]) {
+	return yearMonth.Range().Days()
+}
+
+// Returns the month the specified number of months after yearMonth.
+//
+// # Parameters
+//
+//	months int
+//
+// The number of months to add; negative values move backward.
+//
+// # Returns
+//
+//	result YearMonth
+//
+// The month yearMonth + months.
+func (yearMonth YearMonth) AddMonths(months int) (result YearMonth) {
+	return YearMonth{months: yearMonth.months + int32(months)}
+}
+
+// Returns the month the specified number of years after yearMonth.
+//
+// # Parameters
+//
+//	years int
+//
+// The number of years to add; negative values move backward.
+//
+// # Returns
+//
+//	result YearMonth
+//
+// The month yearMonth + 12 * years.
+func (yearMonth YearMonth) AddYears(years int) (result YearMonth) {
+	return yearMonth.AddMonths(12 * years)
+}
+
+// Returns the number of months from yearMonth to value.
+//
+// # Parameters
+//
+//	value YearMonth
+//
+// # Returns
+//
+//	months int
+//
+// The number of months; negative if value is before yearMonth.
+func (yearMonth YearMonth) MonthsUntil(value YearMonth) (months int) {
+	return int(value.months - yearMonth.months)
+}
+
+// Reports whether yearMonth is before value.
+//
+// # Parameters
+//
+//	value YearMonth
+//
+// # Returns
+//
+//	result bool
+//
+// True if yearMonth is before value, false otherwise.
+func (yearMonth YearMonth) Before(value YearMonth) (result bool) {
+	return yearMonth.months < value.months
+}
+
+// Reports whether yearMonth is after value.
+//
+// # Parameters
+//
+//	value YearMonth
+//
+// # Returns
+//
+//	result bool
+//
+// True if yearMonth is after value, false otherwise.
+func (yearMonth YearMonth) After(value YearMonth) (result bool) {
+	return yearMonth.months > value.months
+}
+
+// Compares yearMonth with value.
+//
+// # Parameters
+//
+//	value YearMonth
+//
+// # Returns
+//
+//	result int
+//
+// -1 if yearMonth is before value, 0 if they are the same month, +1 if yearMonth is after value.
+func (yearMonth YearMonth) Compare(value YearMonth) (result int) {
+	return cmp.Compare(yearMonth.months, value.months)
+}
+
+// Returns the month formatted according to layout.
+//
+// # Parameters
+//
+//	layout string
+//
+// The layout, as for [time.Time.Format]; day elements refer to the first day of the month.
+//
+// # Returns
+//
+//	result string
+//
+// The formatted month; for example, the layout "01/06" gives the MM/YY format.
+func (yearMonth YearMonth) Format(layout string) (result string) {
+	return yearMonth.First().Format(layout)
+}
+
+// Returns the month formatted in the YYYY-MM format, for example 2024-05.
+func (yearMonth YearMonth) String() string {
+	return string(yearMonth.appendText(nil))
+}
+
+func (yearMonth YearMonth) appendText(bytes []byte) []byte {
+	year, month := yearMonth.Year(), int(yearMonth.Month())
+	return append(appendYear(bytes, year), '-', byte('0'+month/10), byte('0'+month%10))
+}
+
+// Implements the [encoding.TextAppender] interface.
+//
+// # Parameters
+//
+//	bytes []byte
+//
+// Array of bytes to add the YYYY-MM formatted month.
+//
+// # Returns
+//
+//	result []byte
+//
+// Array of bytes with added YYYY-MM formatted month.
+//
+//	err error
+//
+// nil value.
+func (yearMonth YearMonth) AppendText(bytes []byte) (result []byte, err error) {
+	return yearMonth.appendText(bytes), nil
+}
+
+// Implements the [encoding.TextMarshaler] interface.
+//
+// # Returns
+//
+//	data []byte
+//
+// The month in the YYYY-MM format.
+//
+//	err error
+//
+// nil value.
+func (yearMonth YearMonth) MarshalText() (data []byte, err error) {
+	return yearMonth.appendText(nil), nil
+}
+
+// Implements the [encoding.TextUnmarshaler] interface.
+//
+// # Parameters
+//
+//	data []byte
+//
+// Text data.
+//
+// # Returns
+//
+//	err error
+//
+// Error when unmarshal problems, nil otherwise.
+//
+// # Remarks
+//
+// The month must be in a format accepted by ParseYearMonth.
+func (yearMonth *YearMonth) UnmarshalText(data []byte) error {
+	parsed, err := ParseYearMonth(string(data))
+	if err != nil {
+		return err
+	}
+	*yearMonth = parsed
+	return nil
+}
+
+// Implements the [encoding/json.Marshaler] interface.
+//
+// # Returns
+//
+//	data []byte
+//
+// The month as a quoted string in the YYYY-MM format.
+//
+//	err error
+//
+// nil value.
+func (yearMonth YearMonth) MarshalJSON() (data []byte, err error) {
+	return append(yearMonth.appendText([]byte{'"'}), '"'), nil
+}
+
+// Implements the [encoding/json.Unmarshaler] interface.
+//
+// # Parameters
+//
+//	data []byte
+//
+// JSON data.
+//
+// # Returns
+//
+//	err error
+//
+// Error when unmarshal problems, nil otherwise.
+//
+// # Remarks
+//
+// The month must be a quoted string in a format accepted by ParseYearMonth.
+func (yearMonth *YearMonth) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("date.YearMonth.UnmarshalJSON: input is not a JSON string")
+	}
+	return yearMonth.UnmarshalText(data[1 : len(data)-1])
+}
+
+// Implements the [database/sql/driver.Valuer] interface.
+//
+// # Returns
+//
+//	value driver.Value
+//
+// The month as a string in the YYYY-MM format.
+//
+//	err error
+//
+// nil value.
+func (yearMonth YearMonth) Value() (value driver.Value, err error) {
+	return yearMonth.String(), nil
+}
+
+// Implements the [database/sql.Scanner] interface.
+//
+// # Parameters
+//
+//	value any
+//
+// Value from database to scan; a time.Time is truncated to its month.
+//
+// # Returns
+//
+//	err error
+//
+// Database scan error.
+func (yearMonth *YearMonth) Scan(value any) (err error) {
+	switch v := value.(type) {
+	case time.Time:
+		*yearMonth = NewYearMonth(v.Year(), v.Month())
+		return nil
+	case string:
+		parsed, err := ParseYearMonth(v)
+		if err != nil {
+			return fmt.Errorf("YearMonth.Scan: cannot parse string %q: %w", v, err)
+		}
+		*yearMonth = parsed
+		return nil
+	case []byte:
+		parsed, err := ParseYearMonth(string(v))
+		if err != nil {
+			return fmt.Errorf("YearMonth.Scan: cannot parse bytes %q: %w", v, err)
+		}
+		*yearMonth = parsed
+		return nil
+	default:
+		return fmt.Errorf("YearMonth.Scan: unsupported type %T", value)
+	}
+}
@@ -0,0 +1,90 @@
+package date
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParseYearMonth(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    YearMonth
+		wantErr bool
+	}{
+		{value: "2024-05", want: NewYearMonth(2024, time.May)},
+		{value: "202405", want: NewYearMonth(2024, time.May)},
+		{value: "05/24", want: NewYearMonth(2024, time.May)},
+		{value: "12/99", want: NewYearMonth(2099, time.December)},
+		{value: "2024-13", wantErr: true},
+		{value: "2024-5", wantErr: true},
+		{value: "5/24", wantErr: true},
+		{value: "+02405", wantErr: true},
+		{value: "2024-05-01", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseYearMonth(tt.value)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseYearMonth() = %v, %v, want %v, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestYearMonth(t *testing.T) {
+	month := New(2024, time.February, 15).YearMonth()
+	if month.Year() != 2024 || month.Month() != time.February || month.String() != "2024-02" {
+		t.Errorf("Date.YearMonth() = %v", month)
+	}
+	if month.First() != New(2024, time.February, 1) || month.Last() != New(2024, time.February, 29) || month.DaysIn() != 29 {
+		t.Errorf("YearMonth = %v to %v, %d days", month.First(), month.Last(), month.DaysIn())
+	}
+	if days := slices.Collect(month.Days()); len(days) != 29 || days[28] != month.Last() {
+		t.Errorf("YearMonth.Days() = %v", days)
+	}
+	next := month.AddMonths(11)
+	if next != NewYearMonth(2025, time.January) || !next.After(month) || !month.Before(next) || month.Compare(next) != -1 || month.MonthsUntil(next) != 11 {
+		t.Errorf("YearMonth.AddMonths(11) = %v, want 2025-01", next)
+	}
+	if got := NewYearMonth(2024, 0); got != NewYearMonth(2023, time.December) {
+		t.Errorf("NewYearMonth(2024, 0) = %v, want 2023-12", got)
+	}
+	if got := month.AddYears(-2025); got.Year() != -1 || got.Month() != time.February {
+		t.Errorf("YearMonth.AddYears(-2025) = %d-%d, want -1-2", got.Year(), got.Month())
+	}
+	if got := month.Format("01/06"); got != "02/24" {
+		t.Errorf("YearMonth.Format() = %q, want %q", got, "02/24")
+	}
+	if got := (YearMonth{}).String(); got != "0001-01" {
+		t.Errorf("YearMonth{}.String() = %q, want %q", got, "0001-01")
+	}
+}
+
+func TestYearMonth_Marshaling(t *testing.T) {
+	type record struct {
+		Month YearMonth `json:"month"`
+	}
+	data, err := json.Marshal(record{Month: NewYearMonth(2024, time.May)})
+	if err != nil || string(data) != `{"month":"2024-05"}` {
+		t.Fatalf("json.Marshal() = %s, %v", data, err)
+	}
+	var got record
+	if err := json.Unmarshal([]byte(`{"month":"05/24"}`), &got); err != nil || got.Month != NewYearMonth(2024, time.May) {
+		t.Errorf("json.Unmarshal() = %v, %v", got.Month, err)
+	}
+	var scanned YearMonth
+	if err := scanned.Scan(time.Date(2024, time.May, 17, 0, 0, 0, 0, time.UTC)); err != nil || scanned != NewYearMonth(2024, time.May) {
+		t.Errorf("YearMonth.Scan() = %v, %v", scanned, err)
+	}
+	if err := scanned.Scan("202406"); err != nil || scanned != NewYearMonth(2024, time.June) {
+		t.Errorf("YearMonth.Scan() = %v, %v", scanned, err)
+	}
+	if value, err := scanned.Value(); err != nil || value != "2024-06" {
+		t.Errorf("YearMonth.Value() = %v, %v", value, err)
+	}
+	if err := scanned.Scan(5); err == nil {
+		t.Errorf("YearMonth.Scan(5) error = nil, want error")
+	}
+}
@@ -0,0 +1,800 @@
+package date
+
+import (
+	"cmp"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// Represents a quarter of a year, such as 2024-Q3.
+//
+// # Remarks
+//
+// YearQuarter is stored as the number of quarters elapsed since the first quarter of year 1.
+// The zero value is 0001-Q1. YearQuarter is comparable, so it can be compared with == and used as a map key.
+type YearQuarter struct {
+	quarters int32
+}
+
+// Represents a half of a year, such as 2024-H2.
+//
+// # Remarks
+//
+// YearHalf is stored as the number of halves elapsed since the first half of year 1.
+// The zero value is 0001-H1. YearHalf is comparable, so it can be compared with == and used as a map key.
+type YearHalf struct {
+	halves int32
+}
+
+// Creates a new instance of the YearQuarter structure to the specified year and quarter.
+//
+// # Parameters
+//
+//	year int
+//
+// The year.
+//
+//	quarter int
+//
+// The quarter, from 1 to 4. Values outside this range are normalized, so quarter 5 is the first quarter of the next year.
+//
+// # Returns
+//
+//	yearQuarter YearQuarter
+//
+// The quarter of the year.
+func NewYearQuarter(year int, quarter int) (yearQuarter YearQuarter) {
+	return YearQuarter{quarters: int32((year-1)*4 + quarter - 1)}
+}
+
+// Creates a new instance of the YearHalf structure to the specified year and half.
+//
+// # Parameters
+//
+//	year int
+//
+// The year.
+//
+//	half int
+//
+// The half, 1 or 2. Values outside this range are normalized, so half 3 is the first half of the next year.
+//
+// # Returns
+//
+//	yearHalf YearHalf
+//
+// The half of the year.
+func NewYearHalf(year int, half int) (yearHalf YearHalf) {
+	return YearHalf{halves: int32((year-1)*2 + half - 1)}
+}
+
+// Returns the quarter of the year of the date, from 1 to 4.
+func (date Date) Quarter() int {
+	return int(date.Month()-1)/3 + 1
+}
+
+// Returns the half of the year of the date, 1 or 2.
+func (date Date) Half() int {
+	return int(date.Month()-1)/6 + 1
+}
+
+// Returns the quarter containing the date.
+//
+// # Returns
+//
+//	yearQuarter YearQuarter
+//
+// The quarter of date.
+func (date Date) YearQuarter() (yearQuarter YearQuarter) {
+	return NewYearQuarter(date.Year(), date.Quarter())
+}
+
+// Returns the half of the year containing the date.
+//
+// # Returns
+//
+//	yearHalf YearHalf
+//
+// The half of date.
+func (date Date) YearHalf() (yearHalf YearHalf) {
+	return NewYearHalf(date.Year(), date.Half())
+}
+
+// Returns the first day of the quarter containing the date.
+//
+// # Returns
+//
+//	result Date
+//
+// The first day of the quarter.
+func (date Date) StartOfQuarter() (result Date) {
+	return date.YearQuarter().Start()
+}
+
+// Returns the last day of the quarter containing the date.
+//
+// # Returns
+//
+//	result Date
+//
+// The last day of the quarter, for example September 30 for any date in the third quarter.
+func (date Date) EndOfQuarter() (result Date) {
+	return date.YearQuarter().Last()
+}
+
+// Returns the first day of the half of the year containing the date.
+//
+// # Returns
+//
+//	result Date
+//
+// January 1 or July 1.
+func (date Date) StartOfHalf() (result Date) {
+	return date.YearHalf().Start()
+}
+
+// Returns the last day of the half of the year containing the date.
+//
+// # Returns
+//
+//	result Date
+//
+// June 30 or December 31.
+func (date Date) EndOfHalf() (result Date) {
+	return date.YearHalf().Last()
+}
+
+// Parses a quarter in the YYYY-Qn or YYYYQn format.
+//
+// # Parameters
+//
+//	value string
+//
+// The quarter to parse, for example 2024-Q3 or 2024Q3.
+//
+// # Returns
+//
+//	yearQuarter YearQuarter
+//
+// The parsed quarter.
+//
+//	err error
+//
+// An error if value is not a valid quarter.
+func ParseYearQuarter(value string) (yearQuarter YearQuarter, err error) {
+	year, quarter, ok := parseYearPart(value, 'Q', 4)
+	if !ok {
+		return YearQuarter{}, fmt.Errorf("date.ParseYearQuarter: invalid quarter %q", value)
+	}
+	return NewYearQuarter(year, quarter), nil
+}
+
+// Parses a half of a year in the YYYY-Hn or YYYYHn format.
+//
+// # Parameters
+//
+//	value string
+//
+// The half to parse, for example 2024-H2 or 2024H2.
+//
+// # Returns
+//
+//	yearHalf YearHalf
+//
+// The parsed half.
+//
+//	err error
+//
+// An error if value is not a valid half.
+func ParseYearHalf(value string) (yearHalf YearHalf, err error) {
+	year, half, ok := parseYearPart(value, 'H', 2)
+	if !ok {
+		return YearHalf{}, fmt.Errorf("date.ParseYearHalf: invalid half %q", value)
+	}
+	return NewYearHalf(year, half), nil
+}
+
+// Parses a value in the YYYY-Xn or YYYYXn format, where X is designator and n is from 1 to maximum.
+func parseYearPart(value string, designator byte, maximum int) (year int, n int, ok bool) {
+	s := value
+	if len(s) == 7 && s[4] == '-' {
+		s = s[:4] + s[5:]
+	}
+	if len(s) != 6 || s[4] != designator || s[5] < '1' || int(s[5]-'0') > maximum {
+		return 0, 0, false
+	}
+	year, err := parseDigits(s[:4])
+	if err != nil {
+		return 0, 0, false
+	}
+	return year, int(s[5] - '0'), true
+}
+
+// Returns the year.
+func (yearQuarter YearQuarter) Year() int {
+	return int(floorDiv(int64(yearQuarter.quarters), 4)) + 1
+}
+
+// Returns the quarter of the year, from 1 to 4.
+func (yearQuarter YearQuarter) Quarter() int {
+	return int(int64(yearQuarter.quarters)-4*floorDiv(int64(yearQuarter.quarters), 4)) + 1
+}
+
+// Returns the first day of the quarter.
+//
+// # Returns
+//
+//	start Date
+//
+// The first day of the quarter.
+func (yearQuarter YearQuarter) Start() (start Date) {
+	return New(yearQuarter.Year(), time.Month(3*yearQuarter.Quarter()-2), 1)
+}
+
+// Returns the first day of the next quarter.
+//
+// # Returns
+//
+//	end Date
+//
+// The day after the last day of the quarter, so that the quarter is [Start(), End()) like a Range.
+func (yearQuarter YearQuarter) End() (end Date) {
+	return yearQuarter.Add(1).Start()
+}
+
+// Returns the last day of the quarter.
+//
+// # Returns
+//
+//	last Date
+//
+// The last day of the quarter.
+func (yearQuarter YearQuarter) Last() (last Date) {
+	return yearQuarter.End().AddDays(-1)
+}
+
+// Returns the days of the quarter as a range.
+//
+// # Returns
+//
+//	result Range
+//
+// The range [Start(), End()).
+func (yearQuarter YearQuarter) Range() (result Range) {
+	return NewHalfOpenRange(yearQuarter.Start(), yearQuarter.End())
+}
+
+// Returns an iterator over the days of the quarter.
+//
+// # Returns
+//
+//	seq iter.Seq[Date]
+//
+// The iterator over the days from Start() to Last().
+func (yearQuarter YearQuarter) Days() (seq iter.Seq[Date]) {
+	return yearQuarter.Range().Days()
+}
+
+// Returns the quarter the specified number of quarters after yearQuarter.
+//
+// # Parameters
+//
+//	quarters int
+//
+// The number of quarters to add; negative values move backward.
+//
+// # Returns
+//
+//	result YearQuarter
+//
+// The quarter yearQuarter + quarters.
+func (yearQuarter YearQuarter) Add(quarters int) (result YearQuarter) {
+	return YearQuarter{quarters: yearQuarter.quarters + int32(quarters)}
+}
+
+// Reports whether yearQuarter is before value.
+//
+// # Parameters
+//
+//	value YearQuarter
+//
+// # Returns
+//
+//	result bool
+//
+// True if yearQuarter is before value, false otherwise.
+func (yearQuarter YearQuarter) Before(value YearQuarter) (result bool) {
+	return yearQuarter.quarters < value.quarters
+}
+
+// Reports whether yearQuarter is after value.
+//
+// # Parameters
+//
+//	value YearQuarter
+//
+// # Returns
+//
+//	result bool
+//
+// True if yearQuarter is after value, false otherwise.
+func (yearQuarter YearQuarter) After(value YearQuarter) (result bool) {
+	return yearQuarter.quarters > value.quarters
+}
+
+// Compares yearQuarter with value.
+//
+// # Parameters
+//
+//	value YearQuarter
+//
+// # Returns
+//
+//	result int
+//
+// -1 if yearQuarter is before value, 0 if they are the same quarter, +1 if yearQuarter is after value.
+func (yearQuarter YearQuarter) Compare(value YearQuarter) (result int) {
+	return cmp.Compare(yearQuarter.quarters, value.quarters)
+}
+
+// Returns the quarter formatted in the YYYY-Qn format, for example 2024-Q3.
+func (yearQuarter YearQuarter) String() string {
+	return string(yearQuarter.appendText(nil))
+}
+
+func (yearQuarter YearQuarter) appendText(bytes []byte) []byte {
+	return append(appendYear(bytes, yearQuarter.Year()), '-', 'Q', byte('0'+yearQuarter.Quarter()))
+}
+
+// Implements the [encoding.TextAppender] interface.
+//
+// # Parameters
+//
+//	bytes []byte
+//
+// Array of bytes to add the YYYY-Qn formatted quarter.
+//
+// # Returns
+//
+//	result []byte
+//
+// Array of bytes with added YYYY-Qn formatted quarter.
+//
+//	err error
+//
+// nil value.
+func (yearQuarter YearQuarter) AppendText(bytes []byte) (result []byte, err error) {
+	return yearQuarter.appendText(bytes), nil
+}
+
+// Implements the [encoding.TextMarshaler] interface.
+//
+// # Returns
+//
+//	data []byte
+//
+// The quarter in the YYYY-Qn format.
+//
+//	err error
+//
+// nil value.
+func (yearQuarter YearQuarter) MarshalText() (data []byte, err error) {
+	return yearQuarter.appendText(nil), nil
+}
+
+// Implements the [encoding.TextUnmarshaler] interface.
+//
+// # Parameters
+//
+//	data []byte
+//
+// Text data.
+//
+// # Returns
+//
+//	err error
+//
+// Error when unmarshal problems, nil otherwise.
+//
+// # Remarks
+//
+// The quarter must be in a format accepted by ParseYearQuarter.
+func (yearQuarter *YearQuarter) UnmarshalText(data []byte) error {
+	parsed, err := ParseYearQuarter(string(data))
+	if err != nil {
+		return err
+	}
+	*yearQuarter = parsed
+	return nil
+}
+
+// Implements the [encoding/json.Marshaler] interface.
+//
+// # Returns
+//
+//	data []byte
+//
+// The quarter as a quoted string in the YYYY-Qn format.
+//
+//	err error
+//
+// nil value.
+func (yearQuarter YearQuarter) MarshalJSON() (data []byte, err error) {
+	return append(yearQuarter.appendText([]byte{'"'}), '"'), nil
+}
+
+// Implements the [encoding/json.Unmarshaler] interface.
+//
+// # Parameters
+//
+//	data []byte
+//
+// JSON data.
+//
+// # Returns
+//
+//	err error
+//
+// Error when unmarshal problems, nil otherwise.
+//
+// # Remarks
+//
+// The quarter must be a quoted string in a format accepted by ParseYearQuarter.
+func (yearQuarter *YearQuarter) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("date.YearQuarter.UnmarshalJSON: input is not a JSON string")
+	}
+	return yearQuarter.UnmarshalText(data[1 : len(data)-1])
+}
+
+// Implements the [database/sql/driver.Valuer] interface.
+//
+// # Returns
+//
+//	value driver.Value
+//
+// The quarter as a string in the YYYY-Qn format.
+//
+//	err error
+//
+// nil value.
+func (yearQuarter YearQuarter) Value() (value driver.Value, err error) {
+	return yearQuarter.String(), nil
+}
+
+// Implements the [database/sql.Scanner] interface.
+//
+// # Parameters
+//
+//	value any
+//
+// Value from database to scan; a time.Time is truncated to its quarter.
+//
+// # Returns
+//
+//	err error
+//
+// Database scan error.
+func (yearQuarter *YearQuarter) Scan(value any) (err error) {
+	switch v := value.(type) {
+	case time.Time:
+		year, month, day := v.Date()
+		*yearQuarter = New(year, month, day).YearQuarter()
+		return nil
+	case string:
+		parsed, err := ParseYearQuarter(v)
+		if err != nil {
+			return fmt.Errorf("YearQuarter.Scan: cannot parse string %q: %w", v, err)
+		}
+		*yearQuarter = parsed
+		return nil
+	case []byte:
+		parsed, err := ParseYearQuarter(string(v))
+		if err != nil {
+			return fmt.Errorf("YearQuarter.Scan: cannot parse bytes %q: %w", v, err)
+		}
+		*yearQuarter = parsed
+		return nil
+	default:
+		return fmt.Errorf("YearQuarter.Scan: unsupported type %T", value)
+	}
+}
+
+// Returns the year.
+func (yearHalf YearHalf) Year() int {
+	return int(floorDiv(int64(yearHalf.halves), 2)) + 1
+}
+
+// Returns the half of the year, 1 or 2.
+func (yearHalf YearHalf) Half() int {
+	return int(int64(yearHalf.halves)-2*floorDiv(int64(yearHalf.halves), 2)) + 1
+}
+
+// Returns the first day of the half.
+//
+// # Returns
+//
+//	start Date
+//
+// January 1 or July 1.
+func (yearHalf YearHalf) Start() (start Date) {
+	return New(yearHalf.Year(), time.Month(6*yearHalf.Half()-5), 1)
+}
+
+// Returns the first day of the next half.
+//
+// # Returns
+//
+//	end Date
+//
+// The day after the last day of the half, so that the half is [Start(), End()) like a Range.
+func (yearHalf YearHalf) End() (end Date) {
+	return yearHalf.Add(1).Start()
+}
+
+// Returns the last day of the half.
+//
+// # Returns
+//
+//	last Date
+//
+// June 30 or December 31.
+func (yearHalf YearHalf) Last() (last Date) {
+	return yearHalf.End().AddDays(-1)
+}
+
+// Returns the days of the half as a range.
+//
+// # Returns
+//
+//	result Range
+//
+// The range [Start(), End()).
+func (yearHalf YearHalf) Range() (result Range) {
+	return NewHalfOpenRange(yearHalf.Start(), yearHalf.End())
+}
+
+// Returns an iterator over the days of the half.
+//
+// # Returns
+//
+//	seq iter.Seq[Date]
+//
+// The iterator over the days from Start() to Last().
+func (yearHalf YearHalf) Days() (seq iter.Seq[Date]) {
+	return yearHalf.Range().Days()
+}
+
+// Returns the half the specified number of halves after yearHalf.
+//
+// # Parameters
+//
+//	halves int
+//
+// The number of halves to add; negative values move backward.
+//
+// # Returns
+//
+//	result YearHalf
+//
+// The half yearHalf + halves.
+func (yearHalf YearHalf) Add(halves int) (result YearHalf) {
+	return YearHalf{halves: yearHalf.halves + int32(halves)}
+}
+
+// Reports whether yearHalf is before value.
+//
+// # Parameters
+//
+//	value YearHalf
+//
+// # Returns
+//
+//	result bool
+//
+// True if yearHalf is before value, false otherwise.
+func (yearHalf YearHalf) Before(value YearHalf) (result bool) {
+	return yearHalf.halves < value.halves
+}
+
+// Reports whether yearHalf is after value.
+//
+// # Parameters
+//
+//	value YearHalf
+//
+// # Returns
+//
+//	result bool
+//
+// True if yearHalf is after value, false otherwise.
+func (yearHalf YearHalf) After(value YearHalf) (result bool) {
+	return yearHalf.halves > value.halves
+}
+
+// Compares yearHalf with value.
+//
+// # Parameters
+//
+//	value YearHalf
+//
+// # Returns
+//
+//	result int
+//
+// -1 if yearHalf is before value, 0 if they are the same half, +1 if yearHalf is after value.
+func (yearHalf YearHalf) Compare(value YearHalf) (result int) {
+	return cmp.Compare(yearHalf.halves, value.halves)
+}
+
+// Returns the half formatted in the YYYY-Hn format, for example 2024-H2.
+func (yearHalf YearHalf) String() string {
+	return string(yearHalf.appendText(nil))
+}
+
+func (yearHalf YearHalf) appendText(bytes []byte) []byte {
+	return append(appendYear(bytes, yearHalf.Year()), '-', 'H', byte('0'+yearHalf.Half()))
+}
+
+// Implements the [encoding.TextAppender] interface.
+//
+// # Parameters
+//
+//	bytes []byte
+//
+// Array of bytes to add the YYYY-Hn formatted half.
+//
+// # Returns
+//
+//	result []byte
+//
+// Array of bytes with added YYYY-Hn formatted half.
+//
+//	err error
+//
+// nil value.
+func (yearHalf YearHalf) AppendText(bytes []byte) (result []byte, err error) {
+	return yearHalf.appendText(bytes), nil
+}
+
+// Implements the [encoding.TextMarshaler] interface.
+//
+// # Returns
+//
+//	data []byte
+//
+// The half in the YYYY-Hn format.
+//
+//	err error
+//
+// nil value.
+func (yearHalf YearHalf) MarshalText() (data []byte, err error) {
+	return yearHalf.appendText(nil), nil
+}
+
+// Implements the [encoding.TextUnmarshaler] interface.
+//
+// # Parameters
+//
+//	data []byte
+//
+// Text data.
+//
+// # Returns
+//
+//	err error
+//
+// Error when unmarshal problems, nil otherwise.
+//
+// # Remarks
+//
+// The half must be in a format accepted by ParseYearHalf.
+func (yearHalf *YearHalf) UnmarshalText(data []byte) error {
+	parsed, err := ParseYearHalf(string(data))
+	if err != nil {
+		return err
+	}
+	*yearHalf = parsed
+	return nil
+}
+
+// Implements the [encoding/json.Marshaler] interface.
+//
+// # Returns
+//
+//	data []byte
+//
+// The half as a quoted string in the YYYY-Hn format.
+//
+//	err error
+//
+// nil value.
+func (yearHalf YearHalf) MarshalJSON() (data []byte, err error) {
+	return append(yearHalf.appendText([]byte{'"'}), '"'), nil
+}
+
+// Implements the [encoding/json.Unmarshaler] interface.
+//
+// # Parameters
+//
+//	data []byte
+//
+// JSON data.
+//
+// # Returns
+//
+//	err error
+//
+// Error when unmarshal problems, nil otherwise.
+//
+// # Remarks
+//
+// The half must be a quoted string in a format accepted by ParseYearHalf.
+func (yearHalf *YearHalf) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("date.YearHalf.UnmarshalJSON: input is not a JSON string")
+	}
+	return yearHalf.UnmarshalText(data[1 : len(data)-1])
+}
+
+// Implements the [database/sql/driver.Valuer] interface.
+//
+// # Returns
+//
+//	value driver.Value
+//
+// The half as a string in the YYYY-Hn format.
+//
+//	err error
+//
+// nil value.
+func (yearHalf YearHalf) Value() (value driver.Value, err error) {
+	return yearHalf.String(), nil
+}
+
+// Implements the [database/sql.Scanner] interface.
+//
+// # Parameters
+//
+//	value any
+//
+// Value from database to scan; a time.Time is truncated to its half of the year.
+//
+// # Returns
+//
+//	err error
+//
+// Database scan error.
+func (yearHalf *YearHalf) Scan(value any) (err error) {
+	switch v := value.(type) {
+	case time.Time:
+		year, month, day := v.Date()
+		*yearHalf = New(year, month, day).YearHalf()
+		return nil
+	case string:
+		parsed, err := ParseYearHalf(v)
+		if err != nil {
+			return fmt.Errorf("YearHalf.Scan: cannot parse string %q: %w", v, err)
+		}
+		*yearHalf = parsed
+		return nil
+	case []byte:
+		parsed, err := ParseYearHalf(string(v))
+		if err != nil {
+			return fmt.Errorf("YearHalf.Scan: cannot parse bytes %q: %w", v, err)
+		}
+		*yearHalf = parsed
+		return nil
+	default:
+		return fmt.Errorf("YearHalf.Scan: unsupported type %T", value)
+	}
+}
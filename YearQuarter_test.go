@@ -0,0 +1,151 @@
+package date
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDate_QuarterBoundaries(t *testing.T) {
+	tests := []struct {
+		date             Date
+		wantQuarter      int
+		wantHalf         int
+		wantQuarterStart Date
+		wantQuarterEnd   Date
+		wantHalfStart    Date
+		wantHalfEnd      Date
+	}{
+		{
+			date: New(2024, time.January, 1), wantQuarter: 1, wantHalf: 1,
+			wantQuarterStart: New(2024, time.January, 1), wantQuarterEnd: New(2024, time.March, 31),
+			wantHalfStart: New(2024, time.January, 1), wantHalfEnd: New(2024, time.June, 30),
+		},
+		{
+			date: New(2024, time.August, 15), wantQuarter: 3, wantHalf: 2,
+			wantQuarterStart: New(2024, time.July, 1), wantQuarterEnd: New(2024, time.September, 30),
+			wantHalfStart: New(2024, time.July, 1), wantHalfEnd: New(2024, time.December, 31),
+		},
+		{
+			date: New(2024, time.December, 31), wantQuarter: 4, wantHalf: 2,
+			wantQuarterStart: New(2024, time.October, 1), wantQuarterEnd: New(2024, time.December, 31),
+			wantHalfStart: New(2024, time.July, 1), wantHalfEnd: New(2024, time.December, 31),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.date.String(), func(t *testing.T) {
+			if got := tt.date.Quarter(); got != tt.wantQuarter {
+				t.Errorf("Date.Quarter() = %v, want %v", got, tt.wantQuarter)
+			}
+			if got := tt.date.Half(); got != tt.wantHalf {
+				t.Errorf("Date.Half() = %v, want %v", got, tt.wantHalf)
+			}
+			if got := tt.date.StartOfQuarter(); got != tt.wantQuarterStart {
+				t.Errorf("Date.StartOfQuarter() = %v, want %v", got, tt.wantQuarterStart)
+			}
+			if got := tt.date.EndOfQuarter(); got != tt.wantQuarterEnd {
+				t.Errorf("Date.EndOfQuarter() = %v, want %v", got, tt.wantQuarterEnd)
+			}
+			if got := tt.date.StartOfHalf(); got != tt.wantHalfStart {
+				t.Errorf("Date.StartOfHalf() = %v, want %v", got, tt.wantHalfStart)
+			}
+			if got := tt.date.EndOfHalf(); got != tt.wantHalfEnd {
+				t.Errorf("Date.EndOfHalf() = %v, want %v", got, tt.wantHalfEnd)
+			}
+		})
+	}
+}
+
+func TestParseYearQuarter(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    YearQuarter
+		wantErr bool
+	}{
+		{value: "2024-Q3", want: NewYearQuarter(2024, 3)},
+		{value: "2024Q3", want: NewYearQuarter(2024, 3)},
+		{value: "2024-Q5", wantErr: true},
+		{value: "2024-Q0", wantErr: true},
+		{value: "2024-H1", wantErr: true},
+		{value: "24-Q3", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseYearQuarter(tt.value)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseYearQuarter() = %v, %v, want %v, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+	if got, err := ParseYearHalf("2024H2"); err != nil || got != NewYearHalf(2024, 2) {
+		t.Errorf("ParseYearHalf() = %v, %v, want 2024-H2", got, err)
+	}
+	if _, err := ParseYearHalf("2024-H3"); err == nil {
+		t.Errorf("ParseYearHalf(%q) error = nil, want error", "2024-H3")
+	}
+}
+
+func TestYearQuarter(t *testing.T) {
+	quarter := New(2024, time.November, 5).YearQuarter()
+	if quarter.Year() != 2024 || quarter.Quarter() != 4 || quarter.String() != "2024-Q4" {
+		t.Errorf("Date.YearQuarter() = %v", quarter)
+	}
+	if quarter.Start() != New(2024, time.October, 1) || quarter.End() != New(2025, time.January, 1) || quarter.Last() != New(2024, time.December, 31) {
+		t.Errorf("YearQuarter = [%v, %v), last %v", quarter.Start(), quarter.End(), quarter.Last())
+	}
+	next := quarter.Add(1)
+	if next != NewYearQuarter(2025, 1) || !next.After(quarter) || !quarter.Before(next) || quarter.Compare(next) != -1 {
+		t.Errorf("YearQuarter.Add(1) = %v, want 2025-Q1", next)
+	}
+	if got := NewYearQuarter(2024, 0); got != NewYearQuarter(2023, 4) {
+		t.Errorf("NewYearQuarter(2024, 0) = %v, want 2023-Q4", got)
+	}
+	if got := quarter.Range().Len(); got != 92 {
+		t.Errorf("YearQuarter.Range().Len() = %v, want 92", got)
+	}
+	half := New(2024, time.November, 5).YearHalf()
+	if half.String() != "2024-H2" || half.Start() != New(2024, time.July, 1) || half.Last() != New(2024, time.December, 31) {
+		t.Errorf("Date.YearHalf() = %v, [%v, %v]", half, half.Start(), half.Last())
+	}
+	if next := half.Add(-3); next != NewYearHalf(2023, 1) || next.Compare(half) != -1 {
+		t.Errorf("YearHalf.Add(-3) = %v, want 2023-H1", next)
+	}
+}
+
+func TestYearQuarter_Marshaling(t *testing.T) {
+	type record struct {
+		Quarter YearQuarter `json:"quarter"`
+		Half    YearHalf    `json:"half"`
+	}
+	data, err := json.Marshal(record{Quarter: NewYearQuarter(2024, 3), Half: NewYearHalf(2024, 2)})
+	if err != nil || string(data) != `{"quarter":"2024-Q3","half":"2024-H2"}` {
+		t.Fatalf("json.Marshal() = %s, %v", data, err)
+	}
+	var got record
+	if err := json.Unmarshal([]byte(`{"quarter":"2024Q1","half":"2025H1"}`), &got); err != nil || got.Quarter != NewYearQuarter(2024, 1) || got.Half != NewYearHalf(2025, 1) {
+		t.Errorf("json.Unmarshal() = %+v, %v", got, err)
+	}
+	var quarter YearQuarter
+	if err := quarter.Scan([]byte("2024-Q2")); err != nil || quarter != NewYearQuarter(2024, 2) {
+		t.Errorf("YearQuarter.Scan() = %v, %v", quarter, err)
+	}
+	if value, err := quarter.Value(); err != nil || value != "2024-Q2" {
+		t.Errorf("YearQuarter.Value() = %v, %v", value, err)
+	}
+	if err := quarter.Scan(time.Date(2024, time.September, 30, 0, 0, 0, 0, time.UTC)); err != nil || quarter != NewYearQuarter(2024, 3) {
+		t.Errorf("YearQuarter.Scan() = %v, %v", quarter, err)
+	}
+	if err := quarter.Scan(2); err == nil {
+		t.Errorf("YearQuarter.Scan(2) error = nil, want error")
+	}
+	var half YearHalf
+	if err := half.Scan("2024-H1"); err != nil || half != NewYearHalf(2024, 1) {
+		t.Errorf("YearHalf.Scan() = %v, %v", half, err)
+	}
+	if err := half.Scan(time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)); err != nil || half != NewYearHalf(2024, 2) {
+		t.Errorf("YearHalf.Scan() = %v, %v", half, err)
+	}
+	if err := half.Scan(1); err == nil {
+		t.Errorf("YearHalf.Scan(1) error = nil, want error")
+	}
+}
@@ -0,0 +1,475 @@
+package date
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+	"time"
+)
+
+// Represents an ISO 8601 week, such as 2024-W05.
+//
+// # Remarks
+//
+// ISO weeks start on Monday and belong to the year of their Thursday, so a week-numbering year has 52 or 53 weeks.
+// The zero value is 0001-W01. YearWeek is comparable, so it can be compared with == and used as a map key.
+type YearWeek struct {
+	monday Date
+}
+
+// Creates a new instance of the YearWeek structure to the specified ISO year and week.
+//
+// # Parameters
+//
+//	year int
+//
+// The ISO week-numbering year.
+//
+//	week int
+//
+// The week of the year. Values outside the year are normalized, so week 0 is the last week of the previous year.
+//
+// # Returns
+//
+//	yearWeek YearWeek
+//
+// The ISO week.
+func NewYearWeek(year int, week int) (yearWeek YearWeek) {
+	return YearWeek{monday: FromISOWeek(year, week, time.Monday)}
+}
+
+// Returns the date of the specified day of an ISO week.
+//
+// # Parameters
+//
+//	year int
+//
+// The ISO week-numbering year.
+//
+//	week int
+//
+// The week of the year. Values outside the year are normalized.
+//
+//	weekday time.Weekday
+//
+// The day of the week.
+//
+// # Returns
+//
+//	date Date
+//
+// The date; for example, FromISOWeek(2025, 1, time.Monday) is December 30, 2024.
+func FromISOWeek(year int, week int, weekday time.Weekday) (date Date) {
+	// January 4 is always in week 1.
+	january4 := New(year, time.January, 4)
+	return january4.AddDays(7*(week-1) - int(january4.Weekday()+6)%7 + int(weekday+6)%7)
+}
+
+// Returns the ISO week containing the date.
+//
+// # Returns
+//
+//	yearWeek YearWeek
+//
+// The ISO week of date.
+func (date Date) YearWeek() (yearWeek YearWeek) {
+	return YearWeek{monday: date.AddDays(-int(date.Weekday()+6) % 7)}
+}
+
+// Returns the date formatted as an ISO 8601 week date, for example 2024-W05-3.
+func (date Date) ISOWeekDate() string {
+	bytes := date.YearWeek().appendText(nil)
+	return string(append(bytes, '-', byte('0'+(date.Weekday()+6)%7+1)))
+}
+
+// Parses an ISO 8601 week date in the extended (2024-W05-3) or basic (2024W053) format.
+//
+// # Parameters
+//
+//	value string
+//
+// The week date to parse.
+//
+// # Returns
+//
+//	date Date
+//
+// The parsed date.
+//
+//	err error
+//
+// An error if value is not a valid week date or the week does not exist in the year.
+func ParseISOWeekDate(value string) (date Date, err error) {
+	yearWeek, rest, err := parseYearWeek(value)
+	if err == nil {
+		extended := len(value) > 4 && value[4] == '-'
+		switch {
+		case extended && len(rest) == 2 && rest[0] == '-' && '1' <= rest[1] && rest[1] <= '7':
+			return yearWeek.monday.AddDays(int(rest[1] - '1')), nil
+		case !extended && len(rest) == 1 && '1' <= rest[0] && rest[0] <= '7':
+			return yearWeek.monday.AddDays(int(rest[0] - '1')), nil
+		}
+	}
+	return Date{}, fmt.Errorf("date.ParseISOWeekDate: invalid week date %q", value)
+}
+
+// Parses an ISO 8601 week in the extended (2024-W05) or basic (2024W05) format.
+//
+// # Parameters
+//
+//	value string
+//
+// The week to parse.
+//
+// # Returns
+//
+//	yearWeek YearWeek
+//
+// The parsed week.
+//
+//	err error
+//
+// An error if value is not a valid week or the week does not exist in the year.
+func ParseYearWeek(value string) (yearWeek YearWeek, err error) {
+	yearWeek, rest, err := parseYearWeek(value)
+	if err != nil || rest != "" {
+		return YearWeek{}, fmt.Errorf("date.ParseYearWeek: invalid week %q", value)
+	}
+	return yearWeek, nil
+}
+
+// Parses the YYYY-Www or YYYYWww prefix of value and returns the rest of value.
+func parseYearWeek(value string) (yearWeek YearWeek, rest string, err error) {
+	s := value
+	if len(s) < 7 {
+		return YearWeek{}, "", errors.New("too short")
+	}
+	year, err := parseDigits(s[:4])
+	if err != nil {
+		return YearWeek{}, "", errors.New("invalid year")
+	}
+	s = s[4:]
+	if s[0] == '-' {
+		s = s[1:]
+	}
+	if len(s) < 3 || s[0] != 'W' || s[1] < '0' || s[1] > '9' || s[2] < '0' || s[2] > '9' {
+		return YearWeek{}, "", errors.New("invalid week")
+	}
+	week := int(s[1]-'0')*10 + int(s[2]-'0')
+	if week < 1 || week > weeksInISOYear(year) {
+		return YearWeek{}, "", errors.New("week out of range")
+	}
+	return NewYearWeek(year, week), s[3:], nil
+}
+
+// Returns the number of ISO weeks in year, 52 or 53.
+func weeksInISOYear(year int) int {
+	_, week := New(year, time.December, 28).ISOWeek()
+	return week
+}
+
+// Returns the ISO week-numbering year.
+func (yearWeek YearWeek) Year() int {
+	year, _ := yearWeek.monday.ISOWeek()
+	return year
+}
+
+// Returns the week of the year, from 1 to 53.
+func (yearWeek YearWeek) Week() int {
+	_, week := yearWeek.monday.ISOWeek()
+	return week
+}
+
+// Returns the Monday starting the week.
+//
+// # Returns
+//
+//	start Date
+//
+// The first day of the week.
+func (yearWeek YearWeek) Start() (start Date) {
+	return yearWeek.monday
+}
+
+// Returns the Monday following the week.
+//
+// # Returns
+//
+//	end Date
+//
+// The day after the last day of the week, so that the week is [Start(), End()) like a Range.
+func (yearWeek YearWeek) End() (end Date) {
+	return yearWeek.monday.AddDays(7)
+}
+
+// Returns the Sunday ending the week.
+//
+// # Returns
+//
+//	last Date
+//
+// The last day of the week.
+func (yearWeek YearWeek) Last() (last Date) {
+	return yearWeek.monday.AddDays(6)
+}
+
+// Returns the days of the week as a range.
+//
+// # Returns
+//
+//	result Range
+//
+// The range [Start(), End()).
+func (yearWeek YearWeek) Range() (result Range) {
+	return NewHalfOpenRange(yearWeek.Start(), yearWeek.End())
+}
+
+// Returns an iterator over the days of the week from Monday to Sunday.
+//
+// # Returns
+//
+//	seq iter.Seq[Date]
+//
+// The iterator over the seven days of the week.
+func (yearWeek YearWeek) Days() (seq iter.Seq[Date]) {
+	return yearWeek.Range().Days()
+}
+
+// Returns the week the specified number of weeks after yearWeek.
+//
+// # Parameters
+//
+//	weeks int
+//
+// The number of weeks to add; negative values move backward.
+//
+// # Returns
+//
+//	result YearWeek
+//
+// The week yearWeek + weeks.
+func (yearWeek YearWeek) Add(weeks int) (result YearWeek) {
+	return YearWeek{monday: yearWeek.monday.AddDays(7 * weeks)}
+}
+
+// Reports whether yearWeek is before value.
+//
+// # Parameters
+//
+//	value YearWeek
+//
+// # Returns
+//
+//	result bool
+//
+// True if yearWeek is before value, false otherwise.
+func (yearWeek YearWeek) Before(value YearWeek) (result bool) {
+	return yearWeek.monday.Before(value.monday)
+}
+
+// Reports whether yearWeek is after value.
+//
+// # Parameters
+//
+//	value YearWeek
+//
+// # Returns
+//
+//	result bool
+//
+// True if yearWeek is after value, false otherwise.
+func (yearWeek YearWeek) After(value YearWeek) (result bool) {
+	return yearWeek.monday.After(value.monday)
+}
+
+// Compares yearWeek with value.
+//
+// # Parameters
+//
+//	value YearWeek
+//
+// # Returns
+//
+//	result int
+//
+// -1 if yearWeek is before value, 0 if they are the same week, +1 if yearWeek is after value.
+func (yearWeek YearWeek) Compare(value YearWeek) (result int) {
+	return yearWeek.monday.Compare(value.monday)
+}
+
+// Returns the week formatted in the ISO 8601 extended format, for example 2024-W05.
+func (yearWeek YearWeek) String() string {
+	return string(yearWeek.appendText(nil))
+}
+
+func (yearWeek YearWeek) appendText(bytes []byte) []byte {
+	year, week := yearWeek.monday.ISOWeek()
+	return append(appendYear(bytes, year), '-', 'W', byte('0'+week/10), byte('0'+week%10))
+}
+
+// Appends year to bytes, padded with zeros to four digits if it is in the range 0 to 9999.
+func appendYear(bytes []byte, year int) []byte {
+	if 0 <= year && year <= 9999 {
+		return append(bytes, byte('0'+year/1000), byte('0'+year/100%10), byte('0'+year/10%10), byte('0'+year%10))
+	}
+	return strconv.AppendInt(bytes, int64(year), 10)
+}
+
+// Implements the [encoding.TextAppender] interface.
+//
+// # Parameters
+//
+//	bytes []byte
+//
+// Array of bytes to add the ISO 8601 formatted week.
+//
+// # Returns
+//
+//	result []byte
+//
+// Array of bytes with added ISO 8601 formatted week.
+//
+//	err error
+//
+// nil value.
+func (yearWeek YearWeek) AppendText(bytes []byte) (result []byte, err error) {
+	return yearWeek.appendText(bytes), nil
+}
+
+// Implements the [encoding.TextMarshaler] interface.
+//
+// # Returns
+//
+//	data []byte
+//
+// The week in the YYYY-Www format.
+//
+//	err error
+//
+// nil value.
+func (yearWeek YearWeek) MarshalText() (data []byte, err error) {
+	return yearWeek.appendText(nil), nil
+}
+
+// Implements the [encoding.TextUnmarshaler] interface.
+//
+// # Parameters
+//
+//	data []byte
+//
+// Text data.
+//
+// # Returns
+//
+//	err error
+//
+// Error when unmarshal problems, nil otherwise.
+//
+// # Remarks
+//
+// The week must be in a format accepted by ParseYearWeek.
+func (yearWeek *YearWeek) UnmarshalText(data []byte) error {
+	parsed, err := ParseYearWeek(string(data))
+	if err != nil {
+		return err
+	}
+	*yearWeek = parsed
+	return nil
+}
+
+// Implements the [encoding/json.Marshaler] interface.
+//
+// # Returns
+//
+//	data []byte
+//
+// The week as a quoted string in the YYYY-Www format.
+//
+//	err error
+//
+// nil value.
+func (yearWeek YearWeek) MarshalJSON() (data []byte, err error) {
+	return append(yearWeek.appendText([]byte{'"'}), '"'), nil
+}
+
+// Implements the [encoding/json.Unmarshaler] interface.
+//
+// # Parameters
+//
+//	data []byte
+//
+// JSON data.
+//
+// # Returns
+//
+//	err error
+//
+// Error when unmarshal problems, nil otherwise.
+//
+// # Remarks
+//
+// The week must be a quoted string in a format accepted by ParseYearWeek.
+func (yearWeek *YearWeek) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("date.YearWeek.UnmarshalJSON: input is not a JSON string")
+	}
+	return yearWeek.UnmarshalText(data[1 : len(data)-1])
+}
+
+// Implements the [database/sql/driver.Valuer] interface.
+//
+// # Returns
+//
+//	value driver.Value
+//
+// The week as a string in the YYYY-Www format.
+//
+//	err error
+//
+// nil value.
+func (yearWeek YearWeek) Value() (value driver.Value, err error) {
+	return yearWeek.String(), nil
+}
+
+// Implements the [database/sql.Scanner] interface.
+//
+// # Parameters
+//
+//	value any
+//
+// Value from database to scan; a time.Time is truncated to its week.
+//
+// # Returns
+//
+//	err error
+//
+// Database scan error.
+func (yearWeek *YearWeek) Scan(value any) (err error) {
+	switch v := value.(type) {
+	case time.Time:
+		year, month, day := v.Date()
+		*yearWeek = New(year, month, day).YearWeek()
+		return nil
+	case string:
+		parsed, err := ParseYearWeek(v)
+		if err != nil {
+			return fmt.Errorf("YearWeek.Scan: cannot parse string %q: %w", v, err)
+		}
+		*yearWeek = parsed
+		return nil
+	case []byte:
+		parsed, err := ParseYearWeek(string(v))
+		if err != nil {
+			return fmt.Errorf("YearWeek.Scan: cannot parse bytes %q: %w", v, err)
+		}
+		*yearWeek = parsed
+		return nil
+	default:
+		return fmt.Errorf("YearWeek.Scan: unsupported type %T", value)
+	}
+}
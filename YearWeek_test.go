@@ -0,0 +1,141 @@
+package date
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestFromISOWeek(t *testing.T) {
+	tests := []struct {
+		name    string
+		year    int
+		week    int
+		weekday time.Weekday
+		want    Date
+	}{
+		{name: "Week 1 starting in previous year", year: 2025, week: 1, weekday: time.Monday, want: New(2024, time.December, 30)},
+		{name: "Week 53 ending in next year", year: 2020, week: 53, weekday: time.Sunday, want: New(2021, time.January, 3)},
+		{name: "Wednesday", year: 2024, week: 5, weekday: time.Wednesday, want: New(2024, time.January, 31)},
+		{name: "Normalized week 0", year: 2024, week: 0, weekday: time.Monday, want: New(2023, time.December, 25)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromISOWeek(tt.year, tt.week, tt.weekday); got != tt.want {
+				t.Errorf("FromISOWeek() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	for date := range NewRange(New(1999, time.December, 1), New(2031, time.January, 31)).Days() {
+		year, week := date.ISOWeek()
+		if got := FromISOWeek(year, week, date.Weekday()); got != date {
+			t.Fatalf("FromISOWeek(%d, %d, %v) = %v, want %v", year, week, date.Weekday(), got, date)
+		}
+		if got := date.YearWeek(); got.Year() != year || got.Week() != week || !got.Range().Contains(date) {
+			t.Fatalf("Date.YearWeek() of %v = %v, want %d-W%02d", date, got, year, week)
+		}
+	}
+}
+
+func TestParseYearWeek(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    YearWeek
+		wantErr bool
+	}{
+		{value: "2024-W05", want: NewYearWeek(2024, 5)},
+		{value: "2024W05", want: NewYearWeek(2024, 5)},
+		{value: "2020-W53", want: NewYearWeek(2020, 53)},
+		{value: "2023-W53", wantErr: true},
+		{value: "2024-W00", wantErr: true},
+		{value: "2024-W5", wantErr: true},
+		{value: "2024-W05-3", wantErr: true},
+		{value: "+024-W05", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseYearWeek(tt.value)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseYearWeek() = %v, %v, want %v, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseISOWeekDate(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Date
+		wantErr bool
+	}{
+		{value: "2024-W05-3", want: New(2024, time.January, 31)},
+		{value: "2024W053", want: New(2024, time.January, 31)},
+		{value: "2025-W01-1", want: New(2024, time.December, 30)},
+		{value: "2024-W05-8", wantErr: true},
+		{value: "2024W05-3", wantErr: true},
+		{value: "2024-W053", wantErr: true},
+		{value: "2024-W05", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseISOWeekDate(tt.value)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("ParseISOWeekDate() = %v, %v, want %v, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+	if got := New(2024, time.December, 30).ISOWeekDate(); got != "2025-W01-1" {
+		t.Errorf("Date.ISOWeekDate() = %q, want %q", got, "2025-W01-1")
+	}
+}
+
+func TestYearWeek(t *testing.T) {
+	week := NewYearWeek(2020, 53)
+	if got := week.String(); got != "2020-W53" {
+		t.Errorf("YearWeek.String() = %q, want %q", got, "2020-W53")
+	}
+	if week.Start() != New(2020, time.December, 28) || week.Last() != New(2021, time.January, 3) || week.End() != New(2021, time.January, 4) {
+		t.Errorf("YearWeek = [%v, %v), last %v", week.Start(), week.End(), week.Last())
+	}
+	if got := slices.Collect(week.Days()); len(got) != 7 || got[0] != week.Start() || got[6] != week.Last() {
+		t.Errorf("YearWeek.Days() = %v", got)
+	}
+	next := week.Add(1)
+	if next != NewYearWeek(2021, 1) || !next.After(week) || !week.Before(next) || week.Compare(next) != -1 {
+		t.Errorf("YearWeek.Add(1) = %v, want 2021-W01", next)
+	}
+	if got := (YearWeek{}).String(); got != "0001-W01" {
+		t.Errorf("YearWeek{}.String() = %q, want %q", got, "0001-W01")
+	}
+}
+
+func TestYearWeek_Marshaling(t *testing.T) {
+	type record struct {
+		Week YearWeek `json:"week"`
+	}
+	data, err := json.Marshal(record{Week: NewYearWeek(2024, 5)})
+	if err != nil || string(data) != `{"week":"2024-W05"}` {
+		t.Fatalf("json.Marshal() = %s, %v", data, err)
+	}
+	var got record
+	if err := json.Unmarshal([]byte(`{"week":"2024W05"}`), &got); err != nil || got.Week != NewYearWeek(2024, 5) {
+		t.Errorf("json.Unmarshal() = %v, %v", got.Week, err)
+	}
+	if err := json.Unmarshal([]byte(`{"week":"2024-05"}`), &got); err == nil {
+		t.Errorf("json.Unmarshal() error = nil, want error")
+	}
+	var scanned YearWeek
+	if err := scanned.Scan([]byte("2024-W05")); err != nil || scanned != NewYearWeek(2024, 5) {
+		t.Errorf("YearWeek.Scan() = %v, %v", scanned, err)
+	}
+	if value, err := scanned.Value(); err != nil || value != "2024-W05" {
+		t.Errorf("YearWeek.Value() = %v, %v", value, err)
+	}
+	if err := scanned.Scan(time.Date(2021, time.January, 3, 23, 0, 0, 0, time.UTC)); err != nil || scanned != NewYearWeek(2020, 53) {
+		t.Errorf("YearWeek.Scan() = %v, %v", scanned, err)
+	}
+	if err := scanned.Scan(5); err == nil {
+		t.Errorf("YearWeek.Scan(5) error = nil, want error")
+	}
+}
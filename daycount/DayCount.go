@@ -0,0 +1,313 @@
+// Package daycount implements the financial day-count conventions, which compute the day count and the year fraction between two dates for interest accrual.
+package daycount
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thereisnoplanb/date"
+)
+
+// Specifies a day-count convention.
+type Convention uint8
+
+const (
+	// Actual days divided by 360 (ACT/360, French).
+	Act360 Convention = iota + 1
+	// Actual days divided by 365 (ACT/365F, English).
+	Act365Fixed
+	// Actual days in each calendar year divided by the number of days in that year (ACT/ACT ISDA).
+	ActActISDA
+	// Actual days divided by the actual days of the reference period times the number of periods per year (ACT/ACT ICMA).
+	// It requires Parameters.PeriodStart and Parameters.PeriodEnd.
+	ActActICMA
+	// 30-day months, where day 31 is 30 and the end day 31 is 30 only if the start day is 30 or 31, divided by 360 (30/360 Bond Basis).
+	Thirty360
+	// 30-day months, where day 31 is 30, divided by 360 (30E/360, Eurobond Basis).
+	Thirty360E
+	// 30-day months, where the last day of a month is 30 unless it is the maturity date in February, divided by 360 (30E/360 ISDA, German).
+	// It requires Parameters.Maturity.
+	Thirty360EISDA
+	// Business days divided by 252 (BUS/252, Brazilian).
+	// It requires Parameters.Calendar.
+	Bus252
+)
+
+var conventionNames = [...]string{
+	Act360:         "ACT/360",
+	Act365Fixed:    "ACT/365F",
+	ActActISDA:     "ACT/ACT ISDA",
+	ActActICMA:     "ACT/ACT ICMA",
+	Thirty360:      "30/360",
+	Thirty360E:     "30E/360",
+	Thirty360EISDA: "30E/360 ISDA",
+	Bus252:         "BUS/252",
+}
+
+// The accepted names of the conventions, normalized by normalizeName.
+var conventionAliases = map[string]Convention{
+	"ACT/360":         Act360,
+	"A/360":           Act360,
+	"FRENCH":          Act360,
+	"ACT/365F":        Act365Fixed,
+	"ACT/365FIXED":    Act365Fixed,
+	"A/365F":          Act365Fixed,
+	"ENGLISH":         Act365Fixed,
+	"ACT/ACTISDA":     ActActISDA,
+	"ACT/ACT":         ActActISDA,
+	"A/A":             ActActISDA,
+	"ACT/ACTICMA":     ActActICMA,
+	"ACT/ACTISMA":     ActActICMA,
+	"ISMA-99":         ActActICMA,
+	"30/360":          Thirty360,
+	"30/360BONDBASIS": Thirty360,
+	"BONDBASIS":       Thirty360,
+	"360/360":         Thirty360,
+	"30E/360":         Thirty360E,
+	"30/360ICMA":      Thirty360E,
+	"30S/360":         Thirty360E,
+	"EUROBONDBASIS":   Thirty360E,
+	"30E/360ISDA":     Thirty360EISDA,
+	"GERMAN":          Thirty360EISDA,
+	"BUS/252":         Bus252,
+	"BUSINESS/252":    Bus252,
+}
+
+// The names that are used for more than one convention, such as ACT/365 for ACT/365 Fixed in money markets and for ACT/ACT ISDA in some bond systems.
+var ambiguousNames = []string{"ACT/365", "A/365"}
+
+// Parses a day-count convention from its standard name.
+//
+// # Parameters
+//
+//	name string
+//
+// The name of the convention, for example ACT/360, Actual/365 (Fixed), ACT/ACT ICMA, 30/360 Bond Basis, 30E/360 ISDA, or BUS/252.
+// The name is case-insensitive and Actual may be abbreviated to ACT.
+//
+// # Returns
+//
+//	convention Convention
+//
+// The convention.
+//
+//	err error
+//
+// An error if name is not a known convention or is ambiguous, as ACT/365 is.
+func Parse(name string) (convention Convention, err error) {
+	if slices.Contains(ambiguousNames, normalizeName(name)) {
+		return 0, fmt.Errorf("daycount.Parse: ambiguous convention %q, use ACT/365F or ACT/ACT ISDA", name)
+	}
+	convention, ok := conventionAliases[normalizeName(name)]
+	if !ok {
+		return 0, fmt.Errorf("daycount.Parse: unknown convention %q", name)
+	}
+	return convention, nil
+}
+
+// Normalizes a convention name: upper case, Actual abbreviated to ACT, no spaces and parentheses.
+func normalizeName(name string) string {
+	name = strings.ToUpper(name)
+	name = strings.ReplaceAll(name, "ACTUAL", "ACT")
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '(' || r == ')' {
+			return -1
+		}
+		return r
+	}, name)
+}
+
+// Returns the standard name of the convention, for example ACT/365F.
+func (convention Convention) String() string {
+	if convention >= Act360 && int(convention) < len(conventionNames) {
+		return conventionNames[convention]
+	}
+	return fmt.Sprintf("Convention(%d)", uint8(convention))
+}
+
+// Implements the [encoding.TextMarshaler] interface.
+//
+// # Returns
+//
+//	data []byte
+//
+// The standard name of the convention.
+//
+//	err error
+//
+// An error if the convention is not valid.
+func (convention Convention) MarshalText() (data []byte, err error) {
+	if convention < Act360 || int(convention) >= len(conventionNames) {
+		return nil, fmt.Errorf("daycount.Convention.MarshalText: invalid convention %d", uint8(convention))
+	}
+	return []byte(conventionNames[convention]), nil
+}
+
+// Implements the [encoding.TextUnmarshaler] interface.
+//
+// # Parameters
+//
+//	data []byte
+//
+// Text data with any name accepted by Parse.
+//
+// # Returns
+//
+//	err error
+//
+// An error if data is not a known convention.
+func (convention *Convention) UnmarshalText(data []byte) (err error) {
+	value, ok := conventionAliases[normalizeName(string(data))]
+	if !ok {
+		return fmt.Errorf("daycount.Convention.UnmarshalText: unknown convention %q", data)
+	}
+	*convention = value
+	return nil
+}
+
+// Holds the additional data required by some conventions.
+type Parameters struct {
+	// The start of the regular coupon period containing the accrual period; required by ACT/ACT ICMA.
+	PeriodStart date.Date
+	// The end of the regular coupon period containing the accrual period; required by ACT/ACT ICMA.
+	PeriodEnd date.Date
+	// The maturity date of the instrument; required by 30E/360 ISDA.
+	Maturity date.Date
+	// The business-day calendar, for example a date.BusinessCalendar with a weekend and a holiday predicate; required by BUS/252.
+	Calendar date.Calendar
+}
+
+// Returns the number of days between start and end counted by the convention.
+//
+// # Parameters
+//
+//	start date.Date
+//
+// The start of the accrual period (inclusive).
+//
+//	end date.Date
+//
+// The end of the accrual period (exclusive).
+//
+//	parameters Parameters
+//
+// The additional data required by the convention.
+//
+// # Returns
+//
+//	days int
+//
+// The number of days: actual days, 30-day-month days, or business days. If end is before start, the result is negative.
+//
+//	err error
+//
+// An error if the convention is not valid or a parameter it requires is missing.
+func (convention Convention) DayCount(start date.Date, end date.Date, parameters Parameters) (days int, err error) {
+	if end.Before(start) {
+		days, err = convention.DayCount(end, start, parameters)
+		return -days, err
+	}
+	switch convention {
+	case Act360, Act365Fixed, ActActISDA, ActActICMA:
+		return start.DaysUntil(end), nil
+	case Thirty360, Thirty360E, Thirty360EISDA:
+		y1, m1, d1 := start.Deconstruct()
+		y2, m2, d2 := end.Deconstruct()
+		switch convention {
+		case Thirty360:
+			d1 = min(d1, 30)
+			if d1 == 30 {
+				d2 = min(d2, 30)
+			}
+		case Thirty360E:
+			d1, d2 = min(d1, 30), min(d2, 30)
+		default:
+			if parameters.Maturity.IsZero() {
+				return 0, errors.New("daycount.Convention.DayCount: 30E/360 ISDA requires the maturity date")
+			}
+			if start.IsLastDayOfMonth() {
+				d1 = 30
+			}
+			if end.IsLastDayOfMonth() && (end != parameters.Maturity || m2 != time.February) {
+				d2 = 30
+			}
+		}
+		return 360*(y2-y1) + 30*int(m2-m1) + d2 - d1, nil
+	case Bus252:
+		if parameters.Calendar == nil {
+			return 0, errors.New("daycount.Convention.DayCount: BUS/252 requires a calendar")
+		}
+		return date.BusinessDaysBetween(start, end, parameters.Calendar), nil
+	default:
+		return 0, fmt.Errorf("daycount.Convention.DayCount: invalid convention %d", uint8(convention))
+	}
+}
+
+// Returns the fraction of a year between start and end according to the convention.
+//
+// # Parameters
+//
+//	start date.Date
+//
+// The start of the accrual period (inclusive).
+//
+//	end date.Date
+//
+// The end of the accrual period (exclusive).
+//
+//	parameters Parameters
+//
+// The additional data required by the convention.
+//
+// # Returns
+//
+//	fraction float64
+//
+// The year fraction. If end is before start, the result is negative.
+//
+//	err error
+//
+// An error if the convention is not valid, a parameter it requires is missing, or the ACT/ACT ICMA reference period is shorter than a month.
+//
+// # Remarks
+//
+// For ACT/ACT ICMA, the accrual period should lie within the reference period; the number of periods per year is derived from the length of the reference period in months.
+func (convention Convention) YearFraction(start date.Date, end date.Date, parameters Parameters) (fraction float64, err error) {
+	if end.Before(start) {
+		fraction, err = convention.YearFraction(end, start, parameters)
+		return -fraction, err
+	}
+	days, err := convention.DayCount(start, end, parameters)
+	if err != nil {
+		return 0, err
+	}
+	switch convention {
+	case Act360, Thirty360, Thirty360E, Thirty360EISDA:
+		return float64(days) / 360, nil
+	case Act365Fixed:
+		return float64(days) / 365, nil
+	case Bus252:
+		return float64(days) / 252, nil
+	case ActActISDA:
+		for year := start.Year(); year <= end.Year(); year++ {
+			first, last := date.New(year, time.January, 1), date.New(year+1, time.January, 1)
+			if start.After(first) {
+				first = start
+			}
+			if end.Before(last) {
+				last = end
+			}
+			fraction += float64(first.DaysUntil(last)) / float64(first.DaysInYear())
+		}
+		return fraction, nil
+	default:
+		months := date.MonthsBetween(parameters.PeriodStart, parameters.PeriodEnd)
+		if months <= 0 {
+			return 0, errors.New("daycount.Convention.YearFraction: ACT/ACT ICMA requires a reference period of at least one month")
+		}
+		return float64(days) / float64(parameters.PeriodStart.DaysUntil(parameters.PeriodEnd)) * float64(months) / 12, nil
+	}
+}
@@ -0,0 +1,159 @@
+package daycount
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thereisnoplanb/date"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Convention
+		wantErr bool
+	}{
+		{name: "ACT/360", want: Act360},
+		{name: "Actual/360", want: Act360},
+		{name: "Actual/365 (Fixed)", want: Act365Fixed},
+		{name: "act/365f", want: Act365Fixed},
+		{name: "ACT/ACT", want: ActActISDA},
+		{name: "Actual/Actual (ISDA)", want: ActActISDA},
+		{name: "ACT/ACT ICMA", want: ActActICMA},
+		{name: "30/360 Bond Basis", want: Thirty360},
+		{name: "30E/360", want: Thirty360E},
+		{name: "Eurobond Basis", want: Thirty360E},
+		{name: "30E/360 ISDA", want: Thirty360EISDA},
+		{name: "BUS/252", want: Bus252},
+		{name: "ACT/364", wantErr: true},
+		{name: "ACT/365", wantErr: true},
+		{name: "Actual/365", wantErr: true},
+		{name: "A/365", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.name)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("Parse() = %v, %v, want %v, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConvention_Text(t *testing.T) {
+	for convention := Act360; convention <= Bus252; convention++ {
+		data, err := convention.MarshalText()
+		if err != nil {
+			t.Fatalf("Convention.MarshalText() error = %v", err)
+		}
+		var got Convention
+		if err := got.UnmarshalText(data); err != nil || got != convention {
+			t.Errorf("Convention.UnmarshalText(%q) = %v, %v, want %v", data, got, err, convention)
+		}
+	}
+	if _, err := Convention(0).MarshalText(); err == nil {
+		t.Errorf("Convention(0).MarshalText() error = nil, want error")
+	}
+}
+
+func TestConvention_DayCount(t *testing.T) {
+	tests := []struct {
+		name        string
+		start       date.Date
+		end         date.Date
+		maturity    date.Date
+		want30      int
+		want30E     int
+		want30EISDA int
+	}{
+		{name: "End of February", start: date.New(2007, time.December, 28), end: date.New(2008, time.February, 28), want30: 60, want30E: 60, want30EISDA: 60},
+		{name: "Leap day", start: date.New(2007, time.December, 28), end: date.New(2008, time.February, 29), want30: 61, want30E: 61, want30EISDA: 62},
+		{name: "31st to 30th", start: date.New(2007, time.October, 31), end: date.New(2008, time.November, 30), want30: 390, want30E: 390, want30EISDA: 390},
+		{name: "Leap day to 31st", start: date.New(2008, time.February, 29), end: date.New(2008, time.August, 31), want30: 182, want30E: 181, want30EISDA: 180},
+		{name: "Maturity in February", start: date.New(2007, time.August, 31), end: date.New(2008, time.February, 29), maturity: date.New(2008, time.February, 29), want30: 179, want30E: 179, want30EISDA: 179},
+		{name: "Reversed", start: date.New(2008, time.August, 31), end: date.New(2008, time.February, 29), want30: -182, want30E: -181, want30EISDA: -180},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parameters := Parameters{Maturity: tt.maturity}
+			if parameters.Maturity.IsZero() {
+				parameters.Maturity = date.New(2030, time.January, 1)
+			}
+			for convention, want := range map[Convention]int{Thirty360: tt.want30, Thirty360E: tt.want30E, Thirty360EISDA: tt.want30EISDA} {
+				if got, err := convention.DayCount(tt.start, tt.end, parameters); got != want || err != nil {
+					t.Errorf("%v.DayCount() = %v, %v, want %v", convention, got, err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestConvention_YearFraction(t *testing.T) {
+	holidays := date.NewHolidaySet(date.New(2024, time.January, 1))
+	calendar := date.NewBusinessCalendar(date.SaturdaySunday, holidays.Contains)
+	tests := []struct {
+		name       string
+		convention Convention
+		start      date.Date
+		end        date.Date
+		parameters Parameters
+		want       float64
+	}{
+		{name: "ACT/360", convention: Act360, start: date.New(2024, time.January, 1), end: date.New(2024, time.July, 1), want: 182.0 / 360},
+		{name: "ACT/365F", convention: Act365Fixed, start: date.New(2024, time.January, 1), end: date.New(2024, time.July, 1), want: 182.0 / 365},
+		{name: "ACT/ACT ISDA", convention: ActActISDA, start: date.New(2003, time.November, 1), end: date.New(2004, time.May, 1), want: 61.0/365 + 121.0/366},
+		{
+			name: "ACT/ACT ICMA - regular period", convention: ActActICMA,
+			start: date.New(2003, time.November, 1), end: date.New(2004, time.May, 1),
+			parameters: Parameters{PeriodStart: date.New(2003, time.November, 1), PeriodEnd: date.New(2004, time.May, 1)},
+			want:       0.5,
+		},
+		{
+			name: "ACT/ACT ICMA - short first period", convention: ActActICMA,
+			start: date.New(1999, time.February, 1), end: date.New(1999, time.July, 1),
+			parameters: Parameters{PeriodStart: date.New(1998, time.July, 1), PeriodEnd: date.New(1999, time.July, 1)},
+			want:       150.0 / 365,
+		},
+		{name: "30/360", convention: Thirty360, start: date.New(2024, time.January, 31), end: date.New(2024, time.July, 31), want: 0.5},
+		{
+			name: "BUS/252", convention: Bus252,
+			start: date.New(2024, time.January, 1), end: date.New(2024, time.January, 8),
+			parameters: Parameters{Calendar: calendar},
+			want:       4.0 / 252,
+		},
+		{name: "Reversed", convention: Act360, start: date.New(2024, time.July, 1), end: date.New(2024, time.January, 1), want: -182.0 / 360},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.convention.YearFraction(tt.start, tt.end, tt.parameters)
+			if err != nil || math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("Convention.YearFraction() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvention_YearFraction_Errors(t *testing.T) {
+	start, end := date.New(2024, time.January, 1), date.New(2024, time.July, 1)
+	tests := []struct {
+		name       string
+		convention Convention
+		parameters Parameters
+		wantErr    string
+	}{
+		{name: "ICMA without reference period", convention: ActActICMA, wantErr: "reference period"},
+		{name: "30E/360 ISDA without maturity", convention: Thirty360EISDA, wantErr: "maturity"},
+		{name: "BUS/252 without calendar", convention: Bus252, wantErr: "calendar"},
+		{name: "Invalid convention", convention: Convention(0), wantErr: "invalid convention"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.convention.YearFraction(start, end, tt.parameters)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Convention.YearFraction() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
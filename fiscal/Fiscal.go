@@ -0,0 +1,115 @@
+// Package fiscal maps dates to fiscal years, quarters, periods, and weeks.
+//
+// MonthCalendar covers fiscal years starting on the first day of a chosen month, and RetailCalendar covers 52/53-week retail years divided into 4-4-5, 4-5-4, or 5-4-4 quarters.
+package fiscal
+
+import (
+	"iter"
+
+	"github.com/thereisnoplanb/date"
+)
+
+// Represents the position of a date in a fiscal calendar.
+type Position struct {
+	// The fiscal year.
+	Year int
+	// The fiscal quarter, from 1 to 4.
+	Quarter int
+	// The fiscal period, from 1 to 12.
+	Period int
+	// The fiscal week, from 1 to 53, or to 54 in a MonthCalendar year of 366 days that starts on a Sunday.
+	Week int
+}
+
+// Represents a fiscal calendar.
+//
+// # Remarks
+//
+// The methods returning ranges return the empty range for a quarter, period, or week number that does not exist in the year.
+type Calendar interface {
+	// Returns the position of value in the calendar.
+	Of(value date.Date) Position
+	// Returns the dates of the fiscal year.
+	Year(year int) date.Range
+	// Returns the dates of the fiscal quarter of year.
+	Quarter(year int, quarter int) date.Range
+	// Returns the dates of the fiscal period of year.
+	Period(year int, period int) date.Range
+	// Returns the dates of the fiscal week of year.
+	Week(year int, week int) date.Range
+}
+
+// Returns an iterator over the fiscal quarters of year.
+//
+// # Parameters
+//
+//	calendar Calendar
+//
+// The fiscal calendar.
+//
+//	year int
+//
+// The fiscal year.
+//
+// # Returns
+//
+//	seq iter.Seq2[int, date.Range]
+//
+// The iterator over the quarter numbers and their dates.
+func Quarters(calendar Calendar, year int) (seq iter.Seq2[int, date.Range]) {
+	return numbered(func(quarter int) date.Range { return calendar.Quarter(year, quarter) })
+}
+
+// Returns an iterator over the fiscal periods of year.
+//
+// # Parameters
+//
+//	calendar Calendar
+//
+// The fiscal calendar.
+//
+//	year int
+//
+// The fiscal year.
+//
+// # Returns
+//
+//	seq iter.Seq2[int, date.Range]
+//
+// The iterator over the period numbers and their dates.
+func Periods(calendar Calendar, year int) (seq iter.Seq2[int, date.Range]) {
+	return numbered(func(period int) date.Range { return calendar.Period(year, period) })
+}
+
+// Returns an iterator over the fiscal weeks of year.
+//
+// # Parameters
+//
+//	calendar Calendar
+//
+// The fiscal calendar.
+//
+//	year int
+//
+// The fiscal year.
+//
+// # Returns
+//
+//	seq iter.Seq2[int, date.Range]
+//
+// The iterator over the week numbers and their dates.
+func Weeks(calendar Calendar, year int) (seq iter.Seq2[int, date.Range]) {
+	return numbered(func(week int) date.Range { return calendar.Week(year, week) })
+}
+
+// Returns an iterator over the ranges returned by get for 1, 2, ... until the first empty range.
+func numbered(get func(n int) date.Range) iter.Seq2[int, date.Range] {
+	return func(yield func(int, date.Range) bool) {
+		for n := 1; ; n++ {
+			r := get(n)
+			if r.IsEmpty() || !yield(n, r) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package fiscal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thereisnoplanb/date"
+)
+
+// Represents a fiscal calendar whose years start on the first day of a month and whose periods are calendar months.
+//
+// # Remarks
+//
+// Quarters are three consecutive periods. Weeks start on Monday, like ISO weeks; week 1 is the week containing the first day of the year,
+// and the first and last weeks are cut to the fiscal year. A year has 53 weeks, or 54 if it has 366 days and starts on a Sunday,
+// in which case week 1 is only that Sunday and week 54 is only the last day of the year.
+type MonthCalendar struct {
+	// The month in which the fiscal year starts, for example time.April or time.October; the zero value is January.
+	StartMonth time.Month
+	// If true, a fiscal year is numbered by the calendar year in which it starts, otherwise by the calendar year in which it ends.
+	NameByStartYear bool
+}
+
+// Creates a new fiscal calendar with years starting in startMonth and numbered by the calendar year in which they end.
+//
+// # Parameters
+//
+//	startMonth time.Month
+//
+// The month in which the fiscal year starts.
+//
+// # Returns
+//
+//	calendar MonthCalendar
+//
+// The fiscal calendar; for example, NewMonthCalendar(time.October) is the US federal government calendar, in which fiscal year 2025 starts on October 1, 2024.
+func NewMonthCalendar(startMonth time.Month) (calendar MonthCalendar) {
+	return MonthCalendar{StartMonth: startMonth}
+}
+
+// Reports whether the fields of the calendar are valid.
+//
+// # Returns
+//
+//	err error
+//
+// An error if StartMonth is after December.
+//
+// # Remarks
+//
+// The methods of an invalid calendar return the zero Position and empty ranges.
+func (calendar MonthCalendar) Validate() (err error) {
+	if calendar.StartMonth > time.December {
+		return fmt.Errorf("fiscal.MonthCalendar.Validate: invalid start month %d", calendar.StartMonth)
+	}
+	return nil
+}
+
+// Returns the first month of the fiscal year.
+func (calendar MonthCalendar) startMonth() time.Month {
+	return max(calendar.StartMonth, time.January)
+}
+
+// Returns the calendar year in which fiscal year starts.
+func (calendar MonthCalendar) startYear(year int) int {
+	if calendar.NameByStartYear || calendar.startMonth() == time.January {
+		return year
+	}
+	return year - 1
+}
+
+// Returns the position of value in the calendar.
+//
+// # Parameters
+//
+//	value date.Date
+//
+// The date.
+//
+// # Returns
+//
+//	position Position
+//
+// The fiscal year, quarter, period, and week of value.
+func (calendar MonthCalendar) Of(value date.Date) (position Position) {
+	if calendar.Validate() != nil {
+		return Position{}
+	}
+	months := int(value.Month() - calendar.startMonth())
+	year := value.Year()
+	if months < 0 {
+		months += 12
+		year--
+	}
+	if year != calendar.startYear(year) {
+		year++
+	}
+	start := calendar.Year(year).Start()
+	return Position{
+		Year:    year,
+		Quarter: months/3 + 1,
+		Period:  months + 1,
+		Week:    (start.DaysUntil(value)+mondayOffset(start))/7 + 1,
+	}
+}
+
+// Returns the dates of the fiscal year.
+//
+// # Parameters
+//
+//	year int
+//
+// The fiscal year.
+//
+// # Returns
+//
+//	result date.Range
+//
+// The dates of the fiscal year, or the empty range if the calendar is not valid.
+func (calendar MonthCalendar) Year(year int) (result date.Range) {
+	if calendar.Validate() != nil {
+		return date.Range{}
+	}
+	start := date.New(calendar.startYear(year), calendar.startMonth(), 1)
+	return date.NewHalfOpenRange(start, start.AddYears(1))
+}
+
+// Returns the dates of the fiscal quarter of year.
+//
+// # Parameters
+//
+//	year int
+//
+// The fiscal year.
+//
+//	quarter int
+//
+// The fiscal quarter, from 1 to 4.
+//
+// # Returns
+//
+//	result date.Range
+//
+// The dates of the quarter, or the empty range if quarter is not valid.
+func (calendar MonthCalendar) Quarter(year int, quarter int) (result date.Range) {
+	if quarter < 1 || quarter > 4 || calendar.Validate() != nil {
+		return date.Range{}
+	}
+	start := calendar.Year(year).Start().AddMonths(3 * (quarter - 1))
+	return date.NewHalfOpenRange(start, start.AddMonths(3))
+}
+
+// Returns the dates of the fiscal period of year.
+//
+// # Parameters
+//
+//	year int
+//
+// The fiscal year.
+//
+//	period int
+//
+// The fiscal period, from 1 to 12.
+//
+// # Returns
+//
+//	result date.Range
+//
+// The dates of the period, or the empty range if period is not valid.
+func (calendar MonthCalendar) Period(year int, period int) (result date.Range) {
+	if period < 1 || period > 12 || calendar.Validate() != nil {
+		return date.Range{}
+	}
+	start := calendar.Year(year).Start().AddMonths(period - 1)
+	return date.NewHalfOpenRange(start, start.AddMonths(1))
+}
+
+// Returns the dates of the fiscal week of year.
+//
+// # Parameters
+//
+//	year int
+//
+// The fiscal year.
+//
+//	week int
+//
+// The fiscal week, from 1 to 53, or to 54 in a year of 366 days that starts on a Sunday.
+//
+// # Returns
+//
+//	result date.Range
+//
+// The dates of the week cut to the fiscal year, or the empty range if week is not valid.
+func (calendar MonthCalendar) Week(year int, week int) (result date.Range) {
+	if week < 1 || calendar.Validate() != nil {
+		return date.Range{}
+	}
+	fiscalYear := calendar.Year(year)
+	monday := fiscalYear.Start().AddDays(7*(week-1) - mondayOffset(fiscalYear.Start()))
+	return fiscalYear.Intersect(date.NewHalfOpenRange(monday, monday.AddDays(7)))
+}
+
+// Returns the number of days from the preceding Monday to value.
+func mondayOffset(value date.Date) int {
+	return int(value.Weekday()+6) % 7
+}
@@ -0,0 +1,77 @@
+package fiscal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thereisnoplanb/date"
+)
+
+func TestMonthCalendar_Of(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar MonthCalendar
+		value    date.Date
+		want     Position
+	}{
+		{name: "Calendar year", calendar: MonthCalendar{}, value: date.New(2024, time.May, 15), want: Position{Year: 2024, Quarter: 2, Period: 5, Week: 20}},
+		{name: "US government - first day", calendar: NewMonthCalendar(time.October), value: date.New(2024, time.October, 1), want: Position{Year: 2025, Quarter: 1, Period: 1, Week: 1}},
+		{name: "US government - second week", calendar: NewMonthCalendar(time.October), value: date.New(2024, time.October, 7), want: Position{Year: 2025, Quarter: 1, Period: 1, Week: 2}},
+		{name: "US government - last day", calendar: NewMonthCalendar(time.October), value: date.New(2025, time.September, 30), want: Position{Year: 2025, Quarter: 4, Period: 12, Week: 53}},
+		{name: "UK - first day", calendar: MonthCalendar{StartMonth: time.April, NameByStartYear: true}, value: date.New(2024, time.April, 1), want: Position{Year: 2024, Quarter: 1, Period: 1, Week: 1}},
+		{name: "Leap year starting on Sunday - first day", calendar: MonthCalendar{}, value: date.New(2012, time.January, 1), want: Position{Year: 2012, Quarter: 1, Period: 1, Week: 1}},
+		{name: "Leap year starting on Sunday - last day", calendar: MonthCalendar{}, value: date.New(2012, time.December, 31), want: Position{Year: 2012, Quarter: 4, Period: 12, Week: 54}},
+		{name: "UK - last day", calendar: MonthCalendar{StartMonth: time.April, NameByStartYear: true}, value: date.New(2025, time.March, 31), want: Position{Year: 2024, Quarter: 4, Period: 12, Week: 53}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.calendar.Of(tt.value); got != tt.want {
+				t.Errorf("MonthCalendar.Of() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonthCalendar_Ranges(t *testing.T) {
+	calendar := NewMonthCalendar(time.October)
+	tests := []struct {
+		name string
+		got  date.Range
+		want date.Range
+	}{
+		{name: "Year", got: calendar.Year(2025), want: date.NewRange(date.New(2024, time.October, 1), date.New(2025, time.September, 30))},
+		{name: "Quarter", got: calendar.Quarter(2025, 2), want: date.NewRange(date.New(2025, time.January, 1), date.New(2025, time.March, 31))},
+		{name: "Period", got: calendar.Period(2025, 5), want: date.NewRange(date.New(2025, time.February, 1), date.New(2025, time.February, 28))},
+		{name: "First week", got: calendar.Week(2025, 1), want: date.NewRange(date.New(2024, time.October, 1), date.New(2024, time.October, 6))},
+		{name: "Last week", got: calendar.Week(2025, 53), want: date.NewRange(date.New(2025, time.September, 29), date.New(2025, time.September, 30))},
+		{name: "Invalid week", got: calendar.Week(2025, 54), want: date.Range{}},
+		{name: "Week 54", got: MonthCalendar{}.Week(2012, 54), want: date.NewRange(date.New(2012, time.December, 31), date.New(2012, time.December, 31))},
+		{name: "Invalid week after week 54", got: MonthCalendar{}.Week(2012, 55), want: date.Range{}},
+		{name: "Invalid period", got: calendar.Period(2025, 13), want: date.Range{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonthCalendar_Validate(t *testing.T) {
+	if err := NewMonthCalendar(time.October).Validate(); err != nil {
+		t.Errorf("MonthCalendar.Validate() error = %v", err)
+	}
+	calendar := NewMonthCalendar(13)
+	if err := calendar.Validate(); err == nil {
+		t.Errorf("MonthCalendar.Validate() error = nil, want an error")
+	}
+	if got := calendar.Of(date.New(2024, time.May, 15)); got != (Position{}) {
+		t.Errorf("MonthCalendar.Of() = %+v, want the zero position", got)
+	}
+	for _, got := range []date.Range{calendar.Year(2024), calendar.Quarter(2024, 1), calendar.Period(2024, 1), calendar.Week(2024, 1)} {
+		if !got.IsEmpty() {
+			t.Errorf("MonthCalendar range = %v, want the empty range", got)
+		}
+	}
+}
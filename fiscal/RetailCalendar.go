@@ -0,0 +1,264 @@
+package fiscal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thereisnoplanb/date"
+)
+
+// Specifies the number of weeks in the three periods of each quarter of a retail calendar.
+type Pattern uint8
+
+const (
+	// Periods of 4, 4, and 5 weeks.
+	Pattern445 Pattern = iota
+	// Periods of 4, 5, and 4 weeks.
+	Pattern454
+	// Periods of 5, 4, and 4 weeks.
+	Pattern544
+)
+
+var patternWeeks = [...][3]int{
+	Pattern445: {4, 4, 5},
+	Pattern454: {4, 5, 4},
+	Pattern544: {5, 4, 4},
+}
+
+// Specifies how the last day of a retail year is chosen.
+type YearEnd uint8
+
+const (
+	// The year ends on the last end weekday of the end month.
+	LastWeekdayOfMonth YearEnd = iota
+	// The year ends on the end weekday nearest to the last day of the end month; it can fall in the following month.
+	NearestWeekdayToMonthEnd
+)
+
+// Represents a 52/53-week retail calendar, whose years end on the same weekday and consist of whole weeks.
+//
+// # Remarks
+//
+// A year has 12 periods grouped into quarters by Pattern; in a 53-week year, the extra week is added to period 12.
+// With EndMonth December, EndWeekday Sunday, and NearestWeekdayToMonthEnd, the years and weeks are the ISO 8601 week-numbering years and weeks returned by date.Date.ISOWeek.
+type RetailCalendar struct {
+	// The month in which the year ends; the zero value is December.
+	EndMonth time.Month
+	// The last day of the week of the calendar, for example time.Saturday.
+	EndWeekday time.Weekday
+	// The rule choosing the last day of the year.
+	YearEnd YearEnd
+	// The number of weeks in the periods of a quarter.
+	Pattern Pattern
+	// If true, a year is numbered by the calendar year in which it starts, otherwise by the calendar year of its end month.
+	NameByStartYear bool
+}
+
+// Reports whether the fields of the calendar are valid.
+//
+// # Returns
+//
+//	err error
+//
+// An error if EndMonth, EndWeekday, YearEnd, or Pattern is out of range.
+//
+// # Remarks
+//
+// The methods of an invalid calendar return the zero Position, empty ranges, and zero weeks.
+func (calendar RetailCalendar) Validate() (err error) {
+	switch {
+	case calendar.EndMonth > time.December:
+		return fmt.Errorf("fiscal.RetailCalendar.Validate: invalid end month %d", calendar.EndMonth)
+	case calendar.EndWeekday > time.Saturday:
+		return fmt.Errorf("fiscal.RetailCalendar.Validate: invalid end weekday %d", calendar.EndWeekday)
+	case calendar.YearEnd > NearestWeekdayToMonthEnd:
+		return fmt.Errorf("fiscal.RetailCalendar.Validate: invalid year end %d", calendar.YearEnd)
+	case int(calendar.Pattern) >= len(patternWeeks):
+		return fmt.Errorf("fiscal.RetailCalendar.Validate: invalid pattern %d", calendar.Pattern)
+	}
+	return nil
+}
+
+// Returns the month in which the year ends.
+func (calendar RetailCalendar) endMonth() time.Month {
+	if calendar.EndMonth == 0 {
+		return time.December
+	}
+	return calendar.EndMonth
+}
+
+// Returns the day after the last day of year.
+func (calendar RetailCalendar) end(year int) date.Date {
+	if calendar.NameByStartYear {
+		year++
+	}
+	last := date.New(year, calendar.endMonth()+1, 0)
+	back := int(last.Weekday()-calendar.EndWeekday+7) % 7
+	if calendar.YearEnd == NearestWeekdayToMonthEnd && back > 3 {
+		return last.AddDays(8 - back)
+	}
+	return last.AddDays(1 - back)
+}
+
+// Returns the number of weeks of period in a 52-week year.
+func (calendar RetailCalendar) periodWeeks(period int) int {
+	return patternWeeks[calendar.Pattern][(period-1)%3]
+}
+
+// Returns the position of value in the calendar.
+//
+// # Parameters
+//
+//	value date.Date
+//
+// The date.
+//
+// # Returns
+//
+//	position Position
+//
+// The fiscal year, quarter, period, and week of value.
+func (calendar RetailCalendar) Of(value date.Date) (position Position) {
+	if calendar.Validate() != nil {
+		return Position{}
+	}
+	year := value.Year()
+	if calendar.NameByStartYear {
+		year--
+	}
+	for !value.Before(calendar.end(year)) {
+		year++
+	}
+	for value.Before(calendar.end(year - 1)) {
+		year--
+	}
+	week := calendar.end(year-1).DaysUntil(value)/7 + 1
+	period, weeks := 1, 0
+	for ; period < 12; period++ {
+		weeks += calendar.periodWeeks(period)
+		if week <= weeks {
+			break
+		}
+	}
+	return Position{Year: year, Quarter: (period-1)/3 + 1, Period: period, Week: week}
+}
+
+// Returns the dates of the fiscal year.
+//
+// # Parameters
+//
+//	year int
+//
+// The fiscal year.
+//
+// # Returns
+//
+//	result date.Range
+//
+// The dates of the fiscal year, 364 or 371 days long, or the empty range if the calendar is not valid.
+func (calendar RetailCalendar) Year(year int) (result date.Range) {
+	if calendar.Validate() != nil {
+		return date.Range{}
+	}
+	return date.NewHalfOpenRange(calendar.end(year-1), calendar.end(year))
+}
+
+// Returns the number of weeks in year.
+//
+// # Parameters
+//
+//	year int
+//
+// The fiscal year.
+//
+// # Returns
+//
+//	weeks int
+//
+// 52 or 53, or 0 if the calendar is not valid.
+func (calendar RetailCalendar) WeeksInYear(year int) (weeks int) {
+	return calendar.Year(year).Len() / 7
+}
+
+// Returns the dates of the fiscal quarter of year.
+//
+// # Parameters
+//
+//	year int
+//
+// The fiscal year.
+//
+//	quarter int
+//
+// The fiscal quarter, from 1 to 4.
+//
+// # Returns
+//
+//	result date.Range
+//
+// The dates of the quarter, or the empty range if quarter is not valid.
+func (calendar RetailCalendar) Quarter(year int, quarter int) (result date.Range) {
+	if quarter < 1 || quarter > 4 {
+		return date.Range{}
+	}
+	return date.NewHalfOpenRange(calendar.Period(year, 3*quarter-2).Start(), calendar.Period(year, 3*quarter).End())
+}
+
+// Returns the dates of the fiscal period of year.
+//
+// # Parameters
+//
+//	year int
+//
+// The fiscal year.
+//
+//	period int
+//
+// The fiscal period, from 1 to 12.
+//
+// # Returns
+//
+//	result date.Range
+//
+// The dates of the period, or the empty range if period is not valid.
+func (calendar RetailCalendar) Period(year int, period int) (result date.Range) {
+	if period < 1 || period > 12 || calendar.Validate() != nil {
+		return date.Range{}
+	}
+	fiscalYear := calendar.Year(year)
+	weeks := 0
+	for p := 1; p < period; p++ {
+		weeks += calendar.periodWeeks(p)
+	}
+	start := fiscalYear.Start().AddDays(7 * weeks)
+	end := start.AddDays(7 * calendar.periodWeeks(period))
+	if period == 12 {
+		end = fiscalYear.End()
+	}
+	return date.NewHalfOpenRange(start, end)
+}
+
+// Returns the dates of the fiscal week of year.
+//
+// # Parameters
+//
+//	year int
+//
+// The fiscal year.
+//
+//	week int
+//
+// The fiscal week, from 1 to 52 or 53.
+//
+// # Returns
+//
+//	result date.Range
+//
+// The dates of the week, or the empty range if week is not valid.
+func (calendar RetailCalendar) Week(year int, week int) (result date.Range) {
+	if week < 1 || week > calendar.WeeksInYear(year) {
+		return date.Range{}
+	}
+	start := calendar.Year(year).Start().AddDays(7 * (week - 1))
+	return date.NewHalfOpenRange(start, start.AddDays(7))
+}
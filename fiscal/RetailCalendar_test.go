@@ -0,0 +1,152 @@
+package fiscal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thereisnoplanb/date"
+)
+
+// The 4-5-4 calendar of the US National Retail Federation.
+var nrf = RetailCalendar{EndMonth: time.January, EndWeekday: time.Saturday, YearEnd: NearestWeekdayToMonthEnd, Pattern: Pattern454, NameByStartYear: true}
+
+// A 4-4-5 calendar ending on the last Saturday of September.
+var lastSaturdayOfSeptember = RetailCalendar{EndMonth: time.September, EndWeekday: time.Saturday}
+
+func TestRetailCalendar_Year(t *testing.T) {
+	tests := []struct {
+		name      string
+		calendar  RetailCalendar
+		year      int
+		want      date.Range
+		wantWeeks int
+	}{
+		{name: "NRF 53 weeks", calendar: nrf, year: 2023, want: date.NewRange(date.New(2023, time.January, 29), date.New(2024, time.February, 3)), wantWeeks: 53},
+		{name: "NRF 52 weeks", calendar: nrf, year: 2024, want: date.NewRange(date.New(2024, time.February, 4), date.New(2025, time.February, 1)), wantWeeks: 52},
+		{name: "Last Saturday", calendar: lastSaturdayOfSeptember, year: 2024, want: date.NewRange(date.New(2023, time.October, 1), date.New(2024, time.September, 28)), wantWeeks: 52},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.calendar.Year(tt.year); got != tt.want {
+				t.Errorf("RetailCalendar.Year() = %v, want %v", got, tt.want)
+			}
+			if got := tt.calendar.WeeksInYear(tt.year); got != tt.wantWeeks {
+				t.Errorf("RetailCalendar.WeeksInYear() = %v, want %v", got, tt.wantWeeks)
+			}
+		})
+	}
+}
+
+func TestRetailCalendar_Periods(t *testing.T) {
+	tests := []struct {
+		name string
+		got  date.Range
+		want date.Range
+	}{
+		{name: "4-4-5 first quarter", got: lastSaturdayOfSeptember.Quarter(2024, 1), want: date.NewRange(date.New(2023, time.October, 1), date.New(2023, time.December, 30))},
+		{name: "4-4-5 third period", got: lastSaturdayOfSeptember.Period(2024, 3), want: date.NewRange(date.New(2023, time.November, 26), date.New(2023, time.December, 30))},
+		{name: "4-5-4 second period", got: nrf.Period(2024, 2), want: date.NewRange(date.New(2024, time.March, 3), date.New(2024, time.April, 6))},
+		{name: "53rd week in last period", got: nrf.Period(2023, 12), want: date.NewRange(date.New(2023, time.December, 31), date.New(2024, time.February, 3))},
+		{name: "53rd week", got: nrf.Week(2023, 53), want: date.NewRange(date.New(2024, time.January, 28), date.New(2024, time.February, 3))},
+		{name: "No 53rd week", got: nrf.Week(2024, 53), want: date.Range{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetailCalendar_Of(t *testing.T) {
+	tests := []struct {
+		value date.Date
+		want  Position
+	}{
+		{value: date.New(2024, time.February, 3), want: Position{Year: 2023, Quarter: 4, Period: 12, Week: 53}},
+		{value: date.New(2024, time.February, 4), want: Position{Year: 2024, Quarter: 1, Period: 1, Week: 1}},
+		{value: date.New(2024, time.April, 6), want: Position{Year: 2024, Quarter: 1, Period: 2, Week: 9}},
+		{value: date.New(2024, time.May, 5), want: Position{Year: 2024, Quarter: 2, Period: 4, Week: 14}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value.String(), func(t *testing.T) {
+			if got := nrf.Of(tt.value); got != tt.want {
+				t.Errorf("RetailCalendar.Of() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetailCalendar_ISOWeek(t *testing.T) {
+	iso := RetailCalendar{EndMonth: time.December, EndWeekday: time.Sunday, YearEnd: NearestWeekdayToMonthEnd}
+	for value := range date.NewRange(date.New(1999, time.December, 1), date.New(2030, time.January, 31)).Days() {
+		year, week := value.ISOWeek()
+		if got := iso.Of(value); got.Year != year || got.Week != week {
+			t.Fatalf("RetailCalendar.Of(%v) = %d-W%02d, want %d-W%02d", value, got.Year, got.Week, year, week)
+		}
+	}
+}
+
+func TestWeeks(t *testing.T) {
+	count := 0
+	var last date.Range
+	for week, r := range Weeks(nrf, 2023) {
+		count++
+		if week != count || (count > 1 && r.Start() != last.End()) {
+			t.Fatalf("Weeks() yielded week %d %v after %v", week, r, last)
+		}
+		last = r
+	}
+	if count != 53 || last.End() != nrf.Year(2023).End() {
+		t.Errorf("Weeks() yielded %d weeks ending %v, want 53 weeks ending %v", count, last.End(), nrf.Year(2023).End())
+	}
+	quarters := 0
+	for range Quarters(NewMonthCalendar(time.April), 2025) {
+		quarters++
+	}
+	if quarters != 4 {
+		t.Errorf("Quarters() yielded %d quarters, want 4", quarters)
+	}
+}
+
+func TestRetailCalendar_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar RetailCalendar
+		wantErr  bool
+	}{
+		{name: "NRF", calendar: nrf},
+		{name: "Zero value", calendar: RetailCalendar{}},
+		{name: "Invalid pattern", calendar: RetailCalendar{Pattern: 3}, wantErr: true},
+		{name: "Invalid end weekday", calendar: RetailCalendar{EndWeekday: 7}, wantErr: true},
+		{name: "Invalid end month", calendar: RetailCalendar{EndMonth: 13}, wantErr: true},
+		{name: "Invalid year end", calendar: RetailCalendar{YearEnd: 2}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.calendar.Validate(); (err != nil) != tt.wantErr {
+				t.Fatalf("RetailCalendar.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				return
+			}
+			value := date.New(2024, time.May, 15)
+			if got := tt.calendar.Of(value); got != (Position{}) {
+				t.Errorf("RetailCalendar.Of() = %+v, want the zero position", got)
+			}
+			if got := tt.calendar.Period(2024, 3); !got.IsEmpty() {
+				t.Errorf("RetailCalendar.Period() = %v, want the empty range", got)
+			}
+			if got := tt.calendar.Quarter(2024, 1); !got.IsEmpty() {
+				t.Errorf("RetailCalendar.Quarter() = %v, want the empty range", got)
+			}
+			if got := tt.calendar.Week(2024, 1); !got.IsEmpty() {
+				t.Errorf("RetailCalendar.Week() = %v, want the empty range", got)
+			}
+			if got := tt.calendar.WeeksInYear(2024); got != 0 {
+				t.Errorf("RetailCalendar.WeeksInYear() = %v, want 0", got)
+			}
+		})
+	}
+}